@@ -28,3 +28,29 @@ resource "oci_core_instance" "TFInstance" {
 data "oci_identity_availability_domains" "ADs" {
   compartment_id = "${var.tenancy_ocid}"
 }
+
+# Launch a second instance from the boot volume that was restored from a backup.
+# The boot volume is preserved on termination so that it is destroyed by its own resource.
+resource "oci_core_instance" "TFInstanceFromBootVolume" {
+  availability_domain  = "${oci_core_boot_volume.TFBootVolumeFromSourceBootVolumeBackup.availability_domain}"
+  compartment_id       = "${var.compartment_ocid}"
+  display_name         = "TFInstanceFromBootVolume"
+  shape                = "${var.instance_shape}"
+  preserve_boot_volume = true
+
+  create_vnic_details {
+    subnet_id        = "${oci_core_subnet.ExampleSubnet.id}"
+    display_name     = "primaryvnic"
+    assign_public_ip = true
+    hostname_label   = "tfexamplebvinstance"
+  }
+
+  source_details {
+    source_type = "bootVolume"
+    source_id   = "${oci_core_boot_volume.TFBootVolumeFromSourceBootVolumeBackup.id}"
+  }
+
+  timeouts {
+    create = "60m"
+  }
+}
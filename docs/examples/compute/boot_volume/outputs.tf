@@ -25,3 +25,7 @@ output "bootVolumeBackupFromSourceBootVolumeDatasource" {
 output "bootVolumeFromSourceBootVolumeBackupDatasource" {
   value = ["${data.oci_core_boot_volumes.TFBootVolumeFromSourceBootVolumeBackupDatasource.boot_volumes}"]
 }
+
+output "instanceFromBootVolume" {
+  value = ["${oci_core_instance.TFInstanceFromBootVolume.id}"]
+}
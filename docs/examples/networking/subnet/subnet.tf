@@ -45,3 +45,31 @@ resource "oci_core_subnet" "ExampleSubnet" {
 data "oci_identity_availability_domains" "ADs" {
   compartment_id = "${var.tenancy_ocid}"
 }
+
+/* 
+Existing subnets can be discovered with the oci_core_subnets data source. Use filter blocks to narrow the results by
+any exported attribute, such as the availability domain or the CIDR block.
+*/
+data "oci_core_subnets" "ExampleSubnets" {
+  compartment_id = "${var.compartment_ocid}"
+  vcn_id         = "${oci_core_virtual_network.ExampleVCN.id}"
+
+  filter {
+    name   = "availability_domain"
+    values = ["${oci_core_subnet.ExampleSubnet.availability_domain}"]
+  }
+
+  filter {
+    name   = "cidr_block"
+    values = ["10\\.1\\.\\d+\\.0/24"]
+    regex  = true
+  }
+}
+
+output "subnet_ids" {
+  value = ["${data.oci_core_subnets.ExampleSubnets.subnets.*.id}"]
+}
+
+output "subnet_cidr_blocks" {
+  value = ["${data.oci_core_subnets.ExampleSubnets.subnets.*.cidr_block}"]
+}
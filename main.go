@@ -3,6 +3,9 @@
 package main
 
 import (
+	"flag"
+	"log"
+
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -10,11 +13,25 @@ import (
 )
 
 func main() {
+	var command = flag.String("command", "", "[export] Runs the provider as a tool instead of a Terraform plugin")
+	var compartmentId = flag.String("compartment_id", "", "[export] OCID of the compartment to export resources from")
+	var outputPath = flag.String("output_path", ".", "[export] Directory where the configuration and import commands are written")
+	flag.Parse()
+
 	provider.PrintVersion()
 
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() terraform.ResourceProvider {
-			return provider.Provider(provider.ProviderConfig)
-		},
-	})
+	switch *command {
+	case "":
+		plugin.Serve(&plugin.ServeOpts{
+			ProviderFunc: func() terraform.ResourceProvider {
+				return provider.Provider(provider.ProviderConfig)
+			},
+		})
+	case "export":
+		if err := provider.RunExportCommand(*compartmentId, *outputPath); err != nil {
+			log.Fatalf("[ERROR] export failed: %v", err)
+		}
+	default:
+		log.Fatalf("[ERROR] unknown command %q, the only supported command is \"export\"", *command)
+	}
 }
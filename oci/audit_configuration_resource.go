@@ -86,7 +86,7 @@ func (s *ConfigurationResourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "audit")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "audit")
 
 	response, err := s.Client.GetConfiguration(context.Background(), request)
 	if err != nil {
@@ -110,7 +110,7 @@ func (s *ConfigurationResourceCrud) Update() error {
 		request.RetentionPeriodDays = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "audit")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "audit")
 
 	_, err := s.Client.UpdateConfiguration(context.Background(), request)
 	if err != nil {
@@ -46,9 +46,10 @@ func ClusterResource() *schema.Resource {
 				Required: true,
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -307,7 +308,7 @@ func containerEngineWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 		}
 
 		//Make sure we stop on default rules
-		if shouldRetry(response, false, "containerengine", startTime) {
+		if shouldRetry(response, false, "containerengine", startTime, time.Time{}) {
 			return true
 		}
 
@@ -386,7 +387,7 @@ func (s *ClusterResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 	//Trigger a create request
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 	response, err := s.Client.CreateCluster(context.Background(), request)
 	if err != nil {
 		return err
@@ -402,7 +403,7 @@ func (s *ClusterResourceCrud) Create() error {
 			//Try to clean up
 			delReq := oci_containerengine.DeleteClusterRequest{}
 			delReq.ClusterId = clusterID
-			delReq.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+			delReq.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 			//Issue the delete delReq
 			delRes, delErr := s.Client.DeleteCluster(context.Background(), delReq)
@@ -421,7 +422,7 @@ func (s *ClusterResourceCrud) Create() error {
 	//Fetch the cluster object
 	requestGet := oci_containerengine.GetClusterRequest{}
 	requestGet.ClusterId = clusterID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	requestGet.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 	responseGet, err := s.Client.GetCluster(context.Background(), requestGet)
 	if err != nil {
 		return err
@@ -435,7 +436,7 @@ func (s *ClusterResourceCrud) Get() error {
 	id := s.D.Id()
 	request := oci_containerengine.GetClusterRequest{}
 	request.ClusterId = &id
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.GetCluster(context.Background(), request)
 	if err != nil {
@@ -463,7 +464,7 @@ func (s *ClusterResourceCrud) Update() error {
 	}
 
 	//Issue update request
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 	response, err := s.Client.UpdateCluster(context.Background(), request)
 	if err != nil {
 		return err
@@ -481,7 +482,7 @@ func (s *ClusterResourceCrud) Update() error {
 	//Refresh data
 	requestGet := oci_containerengine.GetClusterRequest{}
 	requestGet.ClusterId = clusterID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	requestGet.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 	responseGet, err := s.Client.GetCluster(context.Background(), requestGet)
 	if err != nil {
 		return err
@@ -495,7 +496,7 @@ func (s *ClusterResourceCrud) Delete() error {
 	request := oci_containerengine.DeleteClusterRequest{}
 	tmp := s.D.Id()
 	request.ClusterId = &tmp
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	//Issue the delete request
 	response, err := s.Client.DeleteCluster(context.Background(), request)
@@ -60,7 +60,8 @@ func NodePoolResource() *schema.Resource {
 				Required: true,
 				Set:      literalTypeHashCodeForSets,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateOcidResourceType("subnet"),
 				},
 			},
 
@@ -294,7 +295,7 @@ func (s *NodePoolResourceCrud) Create() error {
 		request.SubnetIds = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	//Trigger create request
 	response, err := s.Client.CreateNodePool(context.Background(), request)
@@ -313,7 +314,7 @@ func (s *NodePoolResourceCrud) Create() error {
 			//Try to clean up
 			delReq := oci_containerengine.DeleteNodePoolRequest{}
 			delReq.NodePoolId = nodePoolID
-			delReq.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+			delReq.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 			//Issues delete delRequest
 			delRes, delErr := s.Client.DeleteNodePool(context.Background(), delReq)
@@ -333,7 +334,7 @@ func (s *NodePoolResourceCrud) Create() error {
 	//Fetch nodepool object
 	requestGet := oci_containerengine.GetNodePoolRequest{}
 	requestGet.NodePoolId = nodePoolID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	requestGet.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 	responseGet, err := s.Client.GetNodePool(context.Background(), requestGet)
 	if err != nil {
 		return err
@@ -348,7 +349,7 @@ func (s *NodePoolResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.NodePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.GetNodePool(context.Background(), request)
 	if err != nil {
@@ -410,7 +411,7 @@ func (s *NodePoolResourceCrud) Update() error {
 	}
 
 	//Issue update request
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 	response, err := s.Client.UpdateNodePool(context.Background(), request)
 	if err != nil {
 		return err
@@ -428,7 +429,7 @@ func (s *NodePoolResourceCrud) Update() error {
 	//Refresh data
 	requestGet := oci_containerengine.GetNodePoolRequest{}
 	requestGet.NodePoolId = nodePoolID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	requestGet.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 	responseGet, err := s.Client.GetNodePool(context.Background(), requestGet)
 	if err != nil {
 		return err
@@ -444,7 +445,7 @@ func (s *NodePoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NodePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	//Issues delete request
 	response, err := s.Client.DeleteNodePool(context.Background(), request)
@@ -4,11 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -58,9 +54,10 @@ func AppCatalogSubscriptionResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"time_retrieved": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: timestampDiffSuppressFunction,
 			},
 
 			// Computed
@@ -162,7 +159,7 @@ func (s *AppCatalogSubscriptionResourceCrud) Create() error {
 		request.TimeRetrieved = &common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.CreateAppCatalogSubscription(context.Background(), request)
 	if err != nil {
@@ -185,7 +182,7 @@ func (s *AppCatalogSubscriptionResourceCrud) Get() error {
 		ListingId:     &listingId,
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
 	if err != nil {
@@ -248,7 +245,7 @@ func (s *AppCatalogSubscriptionResourceCrud) Delete() error {
 	request.CompartmentId = &compartmentId
 	request.ListingId = &listingId
 	request.ResourceVersion = &listingResourceVersion
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err = s.Client.DeleteAppCatalogSubscription(context.Background(), request)
 	if err != nil {
@@ -306,22 +303,14 @@ func (s *AppCatalogSubscriptionResourceCrud) SetData() error {
 }
 
 func parseSubscriptionCompositeId(compositeId string) (compartmentId string, listingId string, listingResourceVersion string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("compartmentId/.*/listingId/.*/listingResourceVersion/.*", compositeId)
-	if !match || len(parts) != 6 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "compartmentId", "listingId", "listingResourceVersion")
+	if err != nil {
 		return
 	}
-	compartmentId, _ = url.PathUnescape(parts[1])
-	listingId, _ = url.PathUnescape(parts[3])
-	listingResourceVersion, _ = url.PathUnescape(parts[5])
+	compartmentId, listingId, listingResourceVersion = ids[0], ids[1], ids[2]
 	return
 }
 
 func getSubscriptionCompositeId(compartmentId string, listingId string, listingResourceVersion string) string {
-	compartmentId = url.PathEscape(compartmentId)
-	listingId = url.PathEscape(listingId)
-	listingResourceVersion = url.PathEscape(listingResourceVersion)
-	compositeId := "compartmentId/" + compartmentId + "/listingId/" + listingId + "/listingResourceVersion/" + listingResourceVersion
-	return compositeId
+	return buildCompositeId("compartmentId", compartmentId, "listingId", listingId, "listingResourceVersion", listingResourceVersion)
 }
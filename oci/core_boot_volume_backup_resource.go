@@ -194,7 +194,7 @@ func (s *BootVolumeBackupResourceCrud) Create() error {
 		request.Type = oci_core.CreateBootVolumeBackupDetailsTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateBootVolumeBackup(context.Background(), request)
 	if err != nil {
@@ -211,7 +211,7 @@ func (s *BootVolumeBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BootVolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
 	if err != nil {
@@ -245,7 +245,7 @@ func (s *BootVolumeBackupResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateBootVolumeBackup(context.Background(), request)
 	if err != nil {
@@ -262,7 +262,7 @@ func (s *BootVolumeBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BootVolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteBootVolumeBackup(context.Background(), request)
 	return err
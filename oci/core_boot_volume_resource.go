@@ -278,7 +278,7 @@ func (s *BootVolumeResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateBootVolume(context.Background(), request)
 	if err != nil {
@@ -295,7 +295,7 @@ func (s *BootVolumeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BootVolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetBootVolume(context.Background(), request)
 	if err != nil {
@@ -338,7 +338,7 @@ func (s *BootVolumeResourceCrud) Update() error {
 		tmp := s.D.Get("kms_key_id").(string)
 		keyUpdateRequest.KmsKeyId = &tmp
 
-		keyUpdateRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+		keyUpdateRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 		_, err := s.Client.UpdateBootVolumeKmsKey(context.Background(), keyUpdateRequest)
 		if err != nil {
@@ -355,7 +355,7 @@ func (s *BootVolumeResourceCrud) Update() error {
 		request.SizeInGBs = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateBootVolume(context.Background(), request)
 	if err != nil {
@@ -372,7 +372,7 @@ func (s *BootVolumeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BootVolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteBootVolume(context.Background(), request)
 	return err
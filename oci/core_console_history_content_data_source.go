@@ -30,7 +30,7 @@ func ConsoleHistoryContentDataSource() *schema.Resource {
 					}
 
 					if v < 10240 {
-						es = append(es, fmt.Errorf("expected %s to be less than %d, got %d", k, 10240, v))
+						es = append(es, fmt.Errorf("expected %s to be at least %d, got %d", k, 10240, v))
 						return
 					}
 
@@ -23,9 +23,10 @@ func ConsoleHistoryResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("instance"),
 			},
 
 			// Optional
@@ -151,7 +152,7 @@ func (s *ConsoleHistoryResourceCrud) Create() error {
 		request.InstanceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CaptureConsoleHistory(context.Background(), request)
 	if err != nil {
@@ -168,7 +169,7 @@ func (s *ConsoleHistoryResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleHistoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetConsoleHistory(context.Background(), request)
 	if err != nil {
@@ -202,7 +203,7 @@ func (s *ConsoleHistoryResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateConsoleHistory(context.Background(), request)
 	if err != nil {
@@ -219,7 +220,7 @@ func (s *ConsoleHistoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleHistoryId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteConsoleHistory(context.Background(), request)
 	return err
@@ -136,7 +136,7 @@ func (s *CpeResourceCrud) Create() error {
 		request.IpAddress = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateCpe(context.Background(), request)
 	if err != nil {
@@ -153,7 +153,7 @@ func (s *CpeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CpeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetCpe(context.Background(), request)
 	if err != nil {
@@ -187,7 +187,7 @@ func (s *CpeResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateCpe(context.Background(), request)
 	if err != nil {
@@ -204,7 +204,7 @@ func (s *CpeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CpeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteCpe(context.Background(), request)
 	return err
@@ -143,7 +143,7 @@ func (s *CrossConnectGroupResourceCrud) Create() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateCrossConnectGroup(context.Background(), request)
 	if err != nil {
@@ -160,7 +160,7 @@ func (s *CrossConnectGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CrossConnectGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetCrossConnectGroup(context.Background(), request)
 	if err != nil {
@@ -182,7 +182,7 @@ func (s *CrossConnectGroupResourceCrud) Update() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateCrossConnectGroup(context.Background(), request)
 	if err != nil {
@@ -199,7 +199,7 @@ func (s *CrossConnectGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CrossConnectGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteCrossConnectGroup(context.Background(), request)
 	return err
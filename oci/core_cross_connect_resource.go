@@ -224,7 +224,7 @@ func (s *CrossConnectResourceCrud) Create() error {
 		request.PortSpeedShapeName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateCrossConnect(context.Background(), request)
 	if err != nil {
@@ -242,7 +242,7 @@ func (s *CrossConnectResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CrossConnectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetCrossConnect(context.Background(), request)
 	if err != nil {
@@ -273,7 +273,7 @@ func (s *CrossConnectResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateCrossConnect(context.Background(), request)
 	if err != nil {
@@ -290,7 +290,7 @@ func (s *CrossConnectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CrossConnectId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteCrossConnect(context.Background(), request)
 	return err
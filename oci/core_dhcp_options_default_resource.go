@@ -68,7 +68,7 @@ func (s *DefaultDhcpOptionsResourceCrud) reset() error {
 		},
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
 	if err != nil {
@@ -79,9 +79,10 @@ func DhcpOptionsResource() *schema.Resource {
 				},
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -231,7 +232,7 @@ func (s *DhcpOptionsResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateDhcpOptions(context.Background(), request)
 	if err != nil {
@@ -248,7 +249,7 @@ func (s *DhcpOptionsResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DhcpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetDhcpOptions(context.Background(), request)
 	if err != nil {
@@ -298,7 +299,7 @@ func (s *DhcpOptionsResourceCrud) Update() error {
 		request.Options = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
 	if err != nil {
@@ -315,7 +316,7 @@ func (s *DhcpOptionsResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DhcpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteDhcpOptions(context.Background(), request)
 	return err
@@ -28,9 +28,10 @@ func DrgAttachmentResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -157,7 +158,7 @@ func (s *DrgAttachmentResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateDrgAttachment(context.Background(), request)
 	if err != nil {
@@ -174,7 +175,7 @@ func (s *DrgAttachmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DrgAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetDrgAttachment(context.Background(), request)
 	if err != nil {
@@ -201,7 +202,7 @@ func (s *DrgAttachmentResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
 	if err != nil {
@@ -218,7 +219,7 @@ func (s *DrgAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteDrgAttachment(context.Background(), request)
 	return err
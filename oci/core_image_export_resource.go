@@ -0,0 +1,235 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+func ImageExportResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &TwoHours,
+		},
+		Create: createImageExport,
+		Read:   readImageExport,
+		Delete: deleteImageExport,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"destination_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"objectStorageTuple",
+					"objectStorageUri",
+				}, true),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_uri": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"object_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createImageExport(d *schema.ResourceData, m interface{}) error {
+	sync := &ImageExportResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+
+	return CreateResource(d, sync)
+}
+
+func readImageExport(d *schema.ResourceData, m interface{}) error {
+	sync := &ImageExportResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+
+	return ReadResource(sync)
+}
+
+// An export cannot be undone, so destroying the resource only removes it from the state. Neither the image nor the
+// object exported to Object Storage is deleted.
+func deleteImageExport(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type ImageExportResourceCrud struct {
+	BaseCrud
+	Client                 *oci_core.ComputeClient
+	Res                    *oci_core.Image
+	DisableNotFoundRetries bool
+}
+
+// ID is built from the image and a hash of the destination, so that it does not collide with the id of the
+// oci_core_image and each export of the same image has its own id. The destination itself is left out because a
+// destination_uri may contain the token of a pre-authenticated request.
+func (s *ImageExportResourceCrud) ID() string {
+	hash := sha256.Sum256([]byte(s.destination()))
+	return buildCompositeId("images", *s.Res.Id, "destinations", hex.EncodeToString(hash[:]))
+}
+
+// destination returns the URI or the Object Storage path of the object that the image is exported to
+func (s *ImageExportResourceCrud) destination() string {
+	if destinationUri, ok := s.D.GetOkExists("destination_uri"); ok && destinationUri.(string) != "" {
+		return destinationUri.(string)
+	}
+	return fmt.Sprintf("/n/%s/b/%s/o/%s", s.D.Get("namespace_name").(string), s.D.Get("bucket_name").(string), s.D.Get("object_name").(string))
+}
+
+func (s *ImageExportResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_core.ImageLifecycleStateExporting),
+	}
+}
+
+func (s *ImageExportResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.ImageLifecycleStateAvailable),
+	}
+}
+
+func (s *ImageExportResourceCrud) Create() error {
+	request := oci_core.ExportImageRequest{}
+
+	exportImageDetails, err := s.mapToExportImageDetails()
+	if err != nil {
+		return err
+	}
+	request.ExportImageDetails = exportImageDetails
+
+	if imageId, ok := s.D.GetOkExists("image_id"); ok {
+		tmp := imageId.(string)
+		request.ImageId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.ExportImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Image
+	return nil
+}
+
+func (s *ImageExportResourceCrud) Get() error {
+	request := oci_core.GetImageRequest{}
+
+	imageId, _, err := parseImageExportCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+	request.ImageId = &imageId
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Image
+	return nil
+}
+
+func (s *ImageExportResourceCrud) SetData() error {
+	if s.Res.Id != nil {
+		s.D.Set("image_id", *s.Res.Id)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	return nil
+}
+
+func parseImageExportCompositeId(compositeId string) (imageId string, destinationHash string, err error) {
+	ids, err := parseCompositeId(compositeId, "images", "destinations")
+	if err != nil {
+		return
+	}
+	imageId, destinationHash = ids[0], ids[1]
+
+	return
+}
+
+func (s *ImageExportResourceCrud) mapToExportImageDetails() (oci_core.ExportImageDetails, error) {
+	var baseObject oci_core.ExportImageDetails
+	//discriminator
+	destinationTypeRaw, ok := s.D.GetOkExists("destination_type")
+	var destinationType string
+	if ok {
+		destinationType = destinationTypeRaw.(string)
+	} else {
+		destinationType = "" // default value
+	}
+	switch strings.ToLower(destinationType) {
+	case strings.ToLower("objectStorageTuple"):
+		details := oci_core.ExportImageViaObjectStorageTupleDetails{}
+		if bucketName, ok := s.D.GetOkExists("bucket_name"); ok {
+			tmp := bucketName.(string)
+			details.BucketName = &tmp
+		}
+		if namespaceName, ok := s.D.GetOkExists("namespace_name"); ok {
+			tmp := namespaceName.(string)
+			details.NamespaceName = &tmp
+		}
+		if objectName, ok := s.D.GetOkExists("object_name"); ok {
+			tmp := objectName.(string)
+			details.ObjectName = &tmp
+		}
+		baseObject = details
+	case strings.ToLower("objectStorageUri"):
+		details := oci_core.ExportImageViaObjectStorageUriDetails{}
+		if destinationUri, ok := s.D.GetOkExists("destination_uri"); ok {
+			tmp := destinationUri.(string)
+			details.DestinationUri = &tmp
+		}
+		baseObject = details
+	default:
+		return nil, fmt.Errorf("unknown destination_type '%v' was specified", destinationType)
+	}
+	return baseObject, nil
+}
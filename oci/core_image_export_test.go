@@ -0,0 +1,116 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+var (
+	imageExportRepresentation = map[string]interface{}{
+		"destination_type": Representation{repType: Required, create: `objectStorageTuple`},
+		"image_id":         Representation{repType: Required, create: `${oci_core_image.test_image.id}`},
+		"bucket_name":      Representation{repType: Required, create: `${oci_objectstorage_bucket.test_image_export_bucket.name}`},
+		"namespace_name":   Representation{repType: Required, create: `${data.oci_objectstorage_namespace.t.namespace}`},
+		"object_name":      Representation{repType: Required, create: `exported-image`},
+	}
+
+	ImageExportResourceDependencies = ImageRequiredOnlyResource + `
+data "oci_objectstorage_namespace" "t" {
+}
+
+resource "oci_objectstorage_bucket" "test_image_export_bucket" {
+	compartment_id = "${var.compartment_id}"
+	name = "test-image-export-bucket"
+	namespace = "${data.oci_objectstorage_namespace.t.namespace}"
+}
+`
+)
+
+func TestCoreImageExportResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_image_export.test_image_export"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + ImageExportResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_image_export", "test_image_export", Required, Create, imageExportRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "objectStorageTuple"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", "test-image-export-bucket"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttr(resourceName, "object_name", "exported-image"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					TestCheckResourceAttributesEqual(resourceName, "image_id", "oci_core_image.test_image", "id"),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("^images/.+/destinations/[0-9a-f]{64}$")),
+				),
+			},
+		},
+	})
+}
+
+func TestImageExportResourceID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ocid1.image.oc1..aaaa", "lifecycleState": "AVAILABLE"}`))
+	}))
+	defer server.Close()
+	client := &oci_core.ComputeClient{BaseClient: newTestBaseClient(server)}
+
+	ids := map[string]bool{}
+	for _, config := range []map[string]interface{}{
+		{"destination_type": "objectStorageTuple", "image_id": "ocid1.image.oc1..aaaa", "namespace_name": "ns", "bucket_name": "bucket", "object_name": "images/exported"},
+		{"destination_type": "objectStorageTuple", "image_id": "ocid1.image.oc1..aaaa", "namespace_name": "ns", "bucket_name": "bucket", "object_name": "images/exported-again"},
+		{"destination_type": "objectStorageUri", "image_id": "ocid1.image.oc1..aaaa", "destination_uri": "https://objectstorage.us-phoenix-1.oraclecloud.com/p/token/n/ns/b/bucket/o/exported"},
+	} {
+		d := schema.TestResourceDataRaw(t, ImageExportResource().Schema, config)
+		sync := &ImageExportResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+		sync.Res = &oci_core.Image{Id: common.String("ocid1.image.oc1..aaaa")}
+		d.SetId(sync.ID())
+
+		imageId, _, err := parseImageExportCompositeId(d.Id())
+		if err != nil || imageId != "ocid1.image.oc1..aaaa" {
+			t.Errorf("expected the id to start with the image, got %s: %v", d.Id(), err)
+		}
+		if strings.Contains(d.Id(), "token") || strings.Contains(d.Id(), "bucket") {
+			t.Errorf("expected the destination to be left out of the id, got %s", d.Id())
+		}
+		if ids[d.Id()] {
+			t.Errorf("expected each destination to have its own id, got %s twice", d.Id())
+		}
+		ids[d.Id()] = true
+
+		if err := ReadResource(sync); err != nil || d.Id() == "" {
+			t.Errorf("expected the export to be read with its composite id, got %v", err)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, ImageExportResource().Schema, map[string]interface{}{})
+	d.SetId("ocid1.image.oc1..aaaa")
+	if err := (&ImageExportResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}).Get(); err == nil {
+		t.Errorf("expected the id of an image not to be read as an export")
+	}
+}
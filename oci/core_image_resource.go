@@ -107,9 +107,10 @@ func ImageResource() *schema.Resource {
 				},
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("instance"),
 			},
 			"launch_mode": {
 				Type:     schema.TypeString,
@@ -304,7 +305,7 @@ func (s *ImageResourceCrud) Create() error {
 		request.LaunchMode = oci_core.CreateImageDetailsLaunchModeEnum(launchMode.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateImage(context.Background(), request)
 	if err != nil {
@@ -321,7 +322,7 @@ func (s *ImageResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetImage(context.Background(), request)
 	if err != nil {
@@ -355,7 +356,7 @@ func (s *ImageResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateImage(context.Background(), request)
 	if err != nil {
@@ -372,7 +373,7 @@ func (s *ImageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteImage(context.Background(), request)
 	return err
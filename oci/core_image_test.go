@@ -22,6 +22,8 @@ var (
 	imageDataSourceRepresentation = map[string]interface{}{
 		"compartment_id": Representation{repType: Required, create: `${var.compartment_id}`},
 		"display_name":   Representation{repType: Optional, create: `MyCustomImage`, update: `displayName2`},
+		"sort_by":        Representation{repType: Optional, create: `TIMECREATED`},
+		"sort_order":     Representation{repType: Optional, create: `DESC`},
 		"state":          Representation{repType: Optional, create: `AVAILABLE`},
 		"filter":         RepresentationGroup{Required, imageDataSourceFilterRepresentation}}
 	imageDataSourceFilterRepresentation = map[string]interface{}{
@@ -145,6 +147,8 @@ func TestCoreImageResource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
 					resource.TestCheckResourceAttr(datasourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(datasourceName, "sort_by", "TIMECREATED"),
+					resource.TestCheckResourceAttr(datasourceName, "sort_order", "DESC"),
 					resource.TestCheckResourceAttr(datasourceName, "state", "AVAILABLE"),
 
 					resource.TestCheckResourceAttr(datasourceName, "images.#", "1"),
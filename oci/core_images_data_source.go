@@ -35,6 +35,14 @@ func ImagesDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -104,6 +112,16 @@ func (s *ImagesDataSourceCrud) Get() error {
 		request.Shape = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		tmp := sortBy.(string)
+		request.SortBy = oci_core.ListImagesSortByEnum(tmp)
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		tmp := sortOrder.(string)
+		request.SortOrder = oci_core.ListImagesSortOrderEnum(tmp)
+	}
+
 	if state, ok := s.D.GetOkExists("state"); ok {
 		request.LifecycleState = oci_core.ImageLifecycleStateEnum(state.(string))
 	}
@@ -584,7 +584,7 @@ func (s *InstanceConfigurationResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateInstanceConfiguration(context.Background(), request)
 	if err != nil {
@@ -601,7 +601,7 @@ func (s *InstanceConfigurationResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
 	if err != nil {
@@ -635,7 +635,7 @@ func (s *InstanceConfigurationResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstanceConfiguration(context.Background(), request)
 	if err != nil {
@@ -652,7 +652,7 @@ func (s *InstanceConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteInstanceConfiguration(context.Background(), request)
 	return err
@@ -22,9 +22,10 @@ func InstanceConsoleConnectionResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("instance"),
 			},
 			"public_key": {
 				Type:     schema.TypeString,
@@ -159,7 +160,7 @@ func (s *InstanceConsoleConnectionResourceCrud) Create() error {
 		request.PublicKey = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateInstanceConsoleConnection(context.Background(), request)
 	if err != nil {
@@ -176,7 +177,7 @@ func (s *InstanceConsoleConnectionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetInstanceConsoleConnection(context.Background(), request)
 	if err != nil {
@@ -193,7 +194,7 @@ func (s *InstanceConsoleConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteInstanceConsoleConnection(context.Background(), request)
 	return err
@@ -62,8 +62,9 @@ func InstancePoolResource() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									// Required
 									"subnet_id": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateOcidResourceType("subnet"),
 									},
 
 									// Optional
@@ -265,7 +266,7 @@ func (s *InstancePoolResourceCrud) Create() error {
 		request.Size = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateInstancePool(context.Background(), request)
 	if err != nil {
@@ -292,7 +293,7 @@ func (s *InstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolId *s
 	case instancePoolRunningState:
 		startRequest := oci_core.StartInstancePoolRequest{}
 		startRequest.InstancePoolId = instancePoolId
-		startRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+		startRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 		startResponse, err := s.Client.StartInstancePool(context.Background(), startRequest)
 
@@ -300,7 +301,7 @@ func (s *InstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolId *s
 	case instancePoolStoppedState:
 		stopRequest := oci_core.StopInstancePoolRequest{}
 		stopRequest.InstancePoolId = instancePoolId
-		stopRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+		stopRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 		stopResponse, err := s.Client.StopInstancePool(context.Background(), stopRequest)
 
@@ -317,7 +318,7 @@ func (s *InstancePoolResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.InstancePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetInstancePool(context.Background(), request)
 	if err != nil {
@@ -377,7 +378,7 @@ func (s *InstancePoolResourceCrud) Update() error {
 		request.Size = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstancePool(context.Background(), request)
 	if err != nil {
@@ -405,7 +406,7 @@ func (s *InstancePoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstancePoolId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.TerminateInstancePool(context.Background(), request)
 	return err
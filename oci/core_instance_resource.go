@@ -4,12 +4,14 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/customdiff"
 
@@ -19,6 +21,8 @@ import (
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
+const userDataMaxSizeInBytes = 16384
+
 func InstanceResource() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
@@ -64,9 +68,10 @@ func InstanceResource() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						// Required
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateOcidResourceType("subnet"),
 						},
 
 						// Optional
@@ -193,9 +198,11 @@ func InstanceResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"metadata": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     schema.TypeString,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             schema.TypeString,
+				ValidateFunc:     validateInstanceMetadata,
+				DiffSuppressFunc: instanceMetadataUserDataDiffSuppressFunction,
 			},
 			"preserve_boot_volume": {
 				Type:     schema.TypeBool,
@@ -248,10 +255,11 @@ func InstanceResource() *schema.Resource {
 				},
 			},
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("subnet"),
 			},
 
 			// Computed
@@ -309,8 +317,14 @@ func InstanceResource() *schema.Resource {
 				Computed: true,
 			},
 			"state": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.InstanceLifecycleStateRunning),
+					string(oci_core.InstanceLifecycleStateStopped),
+				}, true),
 			},
 			"time_created": {
 				Type:     schema.TypeString,
@@ -334,8 +348,8 @@ func InstanceResource() *schema.Resource {
 		// Updates of 'ssh_authorized_keys' and 'user_data' in Instance 'metadata' should result in Force New
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("metadata", func(old, new, meta interface{}) bool {
-				oldMetadataMap := objectMapToStringMap(old.(map[string]interface{}))
-				newMetadataMap := objectMapToStringMap(new.(map[string]interface{}))
+				oldMetadataMap := mapToInstanceMetadata(old.(map[string]interface{}))
+				newMetadataMap := mapToInstanceMetadata(new.(map[string]interface{}))
 				return (oldMetadataMap["ssh_authorized_keys"] != newMetadataMap["ssh_authorized_keys"]) || (oldMetadataMap["user_data"] != newMetadataMap["user_data"])
 			}),
 		),
@@ -406,6 +420,7 @@ func (s *InstanceResourceCrud) CreatedPending() []string {
 func (s *InstanceResourceCrud) CreatedTarget() []string {
 	return []string{
 		string(oci_core.InstanceLifecycleStateRunning),
+		string(oci_core.InstanceLifecycleStateStopped),
 	}
 }
 
@@ -496,7 +511,7 @@ func (s *InstanceResourceCrud) Create() error {
 	}
 
 	if metadata, ok := s.D.GetOkExists("metadata"); ok {
-		request.Metadata = objectMapToStringMap(metadata.(map[string]interface{}))
+		request.Metadata = mapToInstanceMetadata(metadata.(map[string]interface{}))
 	}
 
 	if shape, ok := s.D.GetOkExists("shape"); ok {
@@ -520,7 +535,7 @@ func (s *InstanceResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.LaunchInstance(context.Background(), request)
 	if err != nil {
@@ -528,16 +543,66 @@ func (s *InstanceResourceCrud) Create() error {
 	}
 
 	s.Res = &response.Instance
+
+	// An instance is always launched into the RUNNING state, so it can only be stopped once it has finished provisioning
+	if desiredState, ok := s.D.GetOkExists("state"); ok && strings.EqualFold(desiredState.(string), string(oci_core.InstanceLifecycleStateStopped)) {
+		s.D.SetId(s.ID())
+		if err := waitForStateRefresh(s, s.D.Timeout(schema.TimeoutCreate), "creation", s.CreatedPending(), []string{string(oci_core.InstanceLifecycleStateRunning)}); err != nil {
+			return err
+		}
+
+		return s.setInstanceDesiredState(desiredState.(string), s.D.Timeout(schema.TimeoutCreate))
+	}
+
 	return nil
 }
 
+func (s *InstanceResourceCrud) setInstanceDesiredState(desiredState string, timeout time.Duration) error {
+	request := oci_core.InstanceActionRequest{}
+
+	tmp := s.D.Id()
+	request.InstanceId = &tmp
+
+	var pending []string
+	var target []string
+	switch oci_core.InstanceLifecycleStateEnum(strings.ToUpper(desiredState)) {
+	case oci_core.InstanceLifecycleStateRunning:
+		request.Action = oci_core.InstanceActionActionStart
+		pending = []string{
+			string(oci_core.InstanceLifecycleStateStopped),
+			string(oci_core.InstanceLifecycleStateStarting),
+		}
+		target = []string{string(oci_core.InstanceLifecycleStateRunning)}
+	case oci_core.InstanceLifecycleStateStopped:
+		request.Action = oci_core.InstanceActionActionStop
+		pending = []string{
+			string(oci_core.InstanceLifecycleStateRunning),
+			string(oci_core.InstanceLifecycleStateStopping),
+		}
+		target = []string{string(oci_core.InstanceLifecycleStateStopped)}
+	default:
+		return fmt.Errorf("received unknown 'state' %s", desiredState)
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.InstanceAction(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Instance
+
+	return waitForStateRefresh(s, timeout, "update", pending, target)
+}
+
 func (s *InstanceResourceCrud) Get() error {
 	request := oci_core.GetInstanceRequest{}
 
 	tmp := s.D.Id()
 	request.InstanceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetInstance(context.Background(), request)
 	if err != nil {
@@ -580,10 +645,10 @@ func (s *InstanceResourceCrud) Update() error {
 	request.InstanceId = &tmp
 
 	if metadata, ok := s.D.GetOkExists("metadata"); ok {
-		request.Metadata = objectMapToStringMap(metadata.(map[string]interface{}))
+		request.Metadata = mapToInstanceMetadata(metadata.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstance(context.Background(), request)
 	if err != nil {
@@ -592,6 +657,12 @@ func (s *InstanceResourceCrud) Update() error {
 
 	s.Res = &response.Instance
 
+	if desiredState, ok := s.D.GetOkExists("state"); ok && s.D.HasChange("state") {
+		if err := s.setInstanceDesiredState(desiredState.(string), s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	// Check for changes in the create_vnic_details sub resource and separately update the vnic
 
 	_, ok := s.D.GetOkExists("create_vnic_details")
@@ -638,7 +709,7 @@ func (s *InstanceResourceCrud) Delete() error {
 		request.PreserveBootVolume = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.TerminateInstance(context.Background(), request)
 	return err
@@ -917,6 +988,10 @@ func (s *InstanceResourceCrud) mapToInstanceSourceDetails(fieldKeyFormat string)
 	switch strings.ToLower(sourceType) {
 	case strings.ToLower("bootVolume"):
 		details := oci_core.InstanceSourceViaBootVolumeDetails{}
+		// The boot volume already exists, so its size cannot be chosen at launch
+		if _, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "boot_volume_size_in_gbs")); ok {
+			return nil, fmt.Errorf("boot_volume_size_in_gbs can only be specified when source_type is 'image'")
+		}
 		if sourceId, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "source_id")); ok {
 			tmp := sourceId.(string)
 			details.BootVolumeId = &tmp
@@ -986,6 +1061,45 @@ func InstanceSourceDetailsToMap(obj *oci_core.InstanceSourceDetails, bootVolume
 	return result
 }
 
+// The service expects 'user_data' to be base64 encoded; plain text such as a cloud-init script is encoded on the user's behalf
+func userDataToBase64(userData string) string {
+	if _, err := base64.StdEncoding.DecodeString(userData); err == nil {
+		return userData
+	}
+	return base64.StdEncoding.EncodeToString([]byte(userData))
+}
+
+func mapToInstanceMetadata(rm map[string]interface{}) map[string]string {
+	result := objectMapToStringMap(rm)
+	if userData, ok := result["user_data"]; ok {
+		result["user_data"] = userDataToBase64(userData)
+	}
+	return result
+}
+
+func validateInstanceMetadata(i interface{}, k string) (warnings []string, errs []error) {
+	metadata, ok := i.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if userData, ok := metadata["user_data"].(string); ok {
+		if size := len(userDataToBase64(userData)); size > userDataMaxSizeInBytes {
+			errs = append(errs, fmt.Errorf("%s: user_data must be at most %d bytes once base64 encoded, got %d", k, userDataMaxSizeInBytes, size))
+		}
+	}
+
+	return
+}
+
+// Suppress the diff between the base64 encoded 'user_data' returned by the service and the plain text in the config
+func instanceMetadataUserDataDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	if key != "metadata.user_data" {
+		return false
+	}
+	return base64ContentDiffSuppressFunction(key, old, new, d)
+}
+
 func mapToExtendedMetadata(rm map[string]interface{}) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
 	for k, v := range rm {
@@ -1005,7 +1119,7 @@ func (s *InstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 		CompartmentId: s.Res.CompartmentId,
 		InstanceId:    s.Res.Id,
 	}
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 	var attachments []oci_core.VnicAttachment
 
 	for {
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -891,6 +892,69 @@ func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_preserveBoot
 	})
 }
 
+// Tests that an instance can be created stopped and then started and stopped without being recreated
+func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_powerState() {
+
+	var instanceId string
+
+	instanceConfig := func(state string) string {
+		return s.Config + fmt.Sprintf(`
+				resource "oci_core_instance" "t" {
+					availability_domain = "${data.oci_identity_availability_domains.ADs.availability_domains.0.name}"
+					compartment_id = "${var.compartment_id}"
+					subnet_id = "${oci_core_subnet.t.id}"
+					image = "${var.InstanceImageOCID[var.region]}"
+					shape = "VM.Standard2.1"
+					state = "%s"
+				}`, state)
+	}
+
+	resource.Test(s.T(), resource.TestCase{
+		Providers: s.Providers,
+		Steps: []resource.TestStep{
+			// verify create in the STOPPED state
+			{
+				Config: instanceConfig("STOPPED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateStopped)),
+					func(ts *terraform.State) (err error) {
+						instanceId, err = fromInstanceState(ts, s.ResourceName, "id")
+						return err
+					},
+				),
+			},
+			// verify start, case insensitively
+			{
+				Config: instanceConfig("running"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateRunning)),
+					func(ts *terraform.State) (err error) {
+						newId, err := fromInstanceState(ts, s.ResourceName, "id")
+						if newId != instanceId {
+							return fmt.Errorf("expected same instance ocid, got different")
+						}
+						return err
+					},
+				),
+			},
+			// verify stop
+			{
+				Config: instanceConfig("STOPPED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateStopped)),
+					func(ts *terraform.State) (err error) {
+						newId, err := fromInstanceState(ts, s.ResourceName, "id")
+						if newId != instanceId {
+							return fmt.Errorf("expected same instance ocid, got different")
+						}
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
 func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_failedByTimeout() {
 
 	testSteps := []resource.TestStep{
@@ -929,6 +993,35 @@ func TestIsStatefulResource(t *testing.T) {
 	var _ StatefulResource = (*InstanceResourceCrud)(nil)
 }
 
+func TestUserDataToBase64(t *testing.T) {
+	encoded := "IyFiaW4vYmFzaAplY2hvIGhlbGxvCg=="
+	if result := userDataToBase64(encoded); result != encoded {
+		t.Errorf("expected already encoded user_data to be unchanged, got %s", result)
+	}
+
+	if result := userDataToBase64("#!bin/bash\necho hello\n"); result != encoded {
+		t.Errorf("expected plain text user_data to be encoded to %s, got %s", encoded, result)
+	}
+
+	if !instanceMetadataUserDataDiffSuppressFunction("metadata.user_data", encoded, "#!bin/bash\necho hello\n", nil) {
+		t.Errorf("expected diff between encoded and plain text user_data to be suppressed")
+	}
+
+	if instanceMetadataUserDataDiffSuppressFunction("metadata.user_data", encoded, "#!bin/bash\necho world\n", nil) {
+		t.Errorf("expected diff between different user_data to not be suppressed")
+	}
+}
+
+func TestValidateInstanceMetadata(t *testing.T) {
+	if _, errs := validateInstanceMetadata(map[string]interface{}{"user_data": strings.Repeat("#", 12288)}, "metadata"); len(errs) != 0 {
+		t.Errorf("expected user_data of 16KB once encoded to be valid, got %v", errs)
+	}
+
+	if _, errs := validateInstanceMetadata(map[string]interface{}{"user_data": strings.Repeat("#", 12289)}, "metadata"); len(errs) != 1 {
+		t.Errorf("expected user_data larger than 16KB once encoded to be invalid")
+	}
+}
+
 func TestResourceCoreInstanceTestSuite(t *testing.T) {
 	suite.Run(t, new(ResourceCoreInstanceTestSuite))
 }
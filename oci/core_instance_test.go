@@ -27,11 +27,12 @@ var (
 	}
 
 	instanceDataSourceRepresentation = map[string]interface{}{
-		"compartment_id":      Representation{repType: Required, create: `${var.compartment_id}`},
-		"availability_domain": Representation{repType: Optional, create: `${data.oci_identity_availability_domains.test_availability_domains.availability_domains.0.name}`},
-		"display_name":        Representation{repType: Optional, create: `displayName`, update: `displayName2`},
-		"state":               Representation{repType: Optional, create: `RUNNING`},
-		"filter":              RepresentationGroup{Required, instanceDataSourceFilterRepresentation}}
+		"compartment_id":               Representation{repType: Required, create: `${var.compartment_id}`},
+		"availability_domain":          Representation{repType: Optional, create: `${data.oci_identity_availability_domains.test_availability_domains.availability_domains.0.name}`},
+		"display_name":                 Representation{repType: Optional, create: `displayName`, update: `displayName2`},
+		"include_primary_vnic_details": Representation{repType: Optional, create: `true`},
+		"state":                        Representation{repType: Optional, create: `RUNNING`},
+		"filter":                       RepresentationGroup{Required, instanceDataSourceFilterRepresentation}}
 	instanceDataSourceFilterRepresentation = map[string]interface{}{
 		"name":   Representation{repType: Required, create: `id`},
 		"values": Representation{repType: Required, create: []string{`${oci_core_instance.test_instance.id}`}},
@@ -271,6 +272,7 @@ func TestCoreInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "availability_domain"),
 					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
 					resource.TestCheckResourceAttr(datasourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(datasourceName, "include_primary_vnic_details", "true"),
 					resource.TestCheckResourceAttr(datasourceName, "state", "RUNNING"),
 
 					resource.TestCheckResourceAttr(datasourceName, "instances.#", "1"),
@@ -285,6 +287,8 @@ func TestCoreInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "instances.0.image"),
 					resource.TestCheckResourceAttr(datasourceName, "instances.0.ipxe_script", "ipxeScript"),
 					resource.TestCheckResourceAttr(datasourceName, "instances.0.metadata.%", "2"),
+					resource.TestCheckResourceAttrSet(datasourceName, "instances.0.private_ip"),
+					resource.TestCheckResourceAttrSet(datasourceName, "instances.0.public_ip"),
 					resource.TestCheckResourceAttrSet(datasourceName, "instances.0.region"),
 					resource.TestCheckResourceAttr(datasourceName, "instances.0.shape", "VM.Standard2.1"),
 					resource.TestCheckResourceAttr(datasourceName, "instances.0.source_details.#", "1"),
@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/core"
@@ -27,6 +28,11 @@ func InstancesDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"include_primary_vnic_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -54,14 +60,17 @@ func readInstances(d *schema.ResourceData, m interface{}) error {
 	sync := &InstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
+	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 
 	return ReadResource(sync)
 }
 
 type InstancesDataSourceCrud struct {
 	BaseCrud
-	Client *oci_core.ComputeClient
-	Res    *oci_core.ListInstancesResponse
+	Client               *oci_core.ComputeClient
+	VirtualNetworkClient *oci_core.VirtualNetworkClient
+	Res                  *oci_core.ListInstancesResponse
+	PrimaryVnics         map[string]*oci_core.Vnic
 }
 
 func (s *InstancesDataSourceCrud) VoidState() {
@@ -110,6 +119,66 @@ func (s *InstancesDataSourceCrud) Get() error {
 		request.Page = listResponse.OpcNextPage
 	}
 
+	if includePrimaryVnicDetails, ok := s.D.GetOkExists("include_primary_vnic_details"); ok && includePrimaryVnicDetails.(bool) {
+		return s.getPrimaryVnics()
+	}
+
+	return nil
+}
+
+// getPrimaryVnics finds the primary VNIC of each running instance. The VNIC attachments are listed once for the
+// compartment, instead of once per instance; the VNICs themselves are read one by one until the primary one is found.
+func (s *InstancesDataSourceCrud) getPrimaryVnics() error {
+	request := oci_core.ListVnicAttachmentsRequest{}
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
+		tmp := availabilityDomain.(string)
+		request.AvailabilityDomain = &tmp
+	}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+
+	attachments := map[string][]oci_core.VnicAttachment{}
+	for {
+		response, err := s.Client.ListVnicAttachments(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		for _, attachment := range response.Items {
+			if attachment.InstanceId != nil && attachment.LifecycleState == oci_core.VnicAttachmentLifecycleStateAttached {
+				attachments[*attachment.InstanceId] = append(attachments[*attachment.InstanceId], attachment)
+			}
+		}
+
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			break
+		}
+	}
+
+	s.PrimaryVnics = map[string]*oci_core.Vnic{}
+	for _, instance := range s.Res.Items {
+		if instance.Id == nil || instance.LifecycleState != oci_core.InstanceLifecycleStateRunning {
+			continue
+		}
+		for _, attachment := range attachments[*instance.Id] {
+			vnicRequest := oci_core.GetVnicRequest{VnicId: attachment.VnicId}
+			vnicRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+			response, err := s.VirtualNetworkClient.GetVnic(context.Background(), vnicRequest)
+			// Ignore errors on GetVnic, since we might not have permissions to view some secondary VNICs.
+			if err == nil && response.Vnic.IsPrimary != nil && *response.Vnic.IsPrimary {
+				vnic := response.Vnic
+				s.PrimaryVnics[*instance.Id] = &vnic
+				break
+			}
+		}
+		if s.PrimaryVnics[*instance.Id] == nil {
+			log.Printf("[WARN] Primary VNIC could not be found for instance %q", *instance.Id)
+		}
+	}
+
 	return nil
 }
 
@@ -200,6 +269,25 @@ func (s *InstancesDataSourceCrud) SetData() error {
 			instance["time_maintenance_reboot_due"] = r.TimeMaintenanceRebootDue.String()
 		}
 
+		if r.Id != nil && s.PrimaryVnics[*r.Id] != nil {
+			vnic := s.PrimaryVnics[*r.Id]
+			if vnic.HostnameLabel != nil {
+				instance["hostname_label"] = *vnic.HostnameLabel
+			}
+
+			if vnic.PublicIp != nil {
+				instance["public_ip"] = *vnic.PublicIp
+			}
+
+			if vnic.PrivateIp != nil {
+				instance["private_ip"] = *vnic.PrivateIp
+			}
+
+			if vnic.SubnetId != nil {
+				instance["subnet_id"] = *vnic.SubnetId
+			}
+		}
+
 		resources = append(resources, instance)
 	}
 
@@ -3,6 +3,9 @@
 package provider
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -164,3 +167,60 @@ func (s *DatasourceCoreInstanceTestSuite) TestAccDatasourceCoreInstance_basic()
 func TestDatasourceCoreInstanceTestSuite(t *testing.T) {
 	suite.Run(t, new(DatasourceCoreInstanceTestSuite))
 }
+
+func TestInstancesDataSourcePrimaryVnics(t *testing.T) {
+	var vnicAttachmentLists, vnicReads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/instances"):
+			w.Write([]byte(`[{"id": "ocid1.instance.oc1..aaaa", "compartmentId": "ocid1.compartment.oc1..aaaa", "lifecycleState": "RUNNING"},
+				{"id": "ocid1.instance.oc1..bbbb", "compartmentId": "ocid1.compartment.oc1..aaaa", "lifecycleState": "STOPPED"}]`))
+		case strings.HasPrefix(r.URL.Path, "/vnicAttachments"):
+			vnicAttachmentLists++
+			w.Write([]byte(`[{"instanceId": "ocid1.instance.oc1..aaaa", "vnicId": "ocid1.vnic.oc1..secondary", "lifecycleState": "ATTACHED"},
+				{"instanceId": "ocid1.instance.oc1..aaaa", "vnicId": "ocid1.vnic.oc1..primary", "lifecycleState": "ATTACHED"},
+				{"instanceId": "ocid1.instance.oc1..bbbb", "vnicId": "ocid1.vnic.oc1..stopped", "lifecycleState": "ATTACHED"}]`))
+		case r.URL.Path == "/vnics/ocid1.vnic.oc1..primary":
+			vnicReads++
+			w.Write([]byte(`{"id": "ocid1.vnic.oc1..primary", "isPrimary": true, "privateIp": "10.0.0.2", "subnetId": "ocid1.subnet.oc1..aaaa"}`))
+		default:
+			vnicReads++
+			w.Write([]byte(`{"id": "ocid1.vnic.oc1..secondary", "isPrimary": false, "privateIp": "10.0.0.3"}`))
+		}
+	}))
+	defer server.Close()
+
+	for _, includePrimaryVnicDetails := range []bool{false, true} {
+		vnicAttachmentLists, vnicReads = 0, 0
+		d := InstancesDataSource().TestResourceData()
+		d.Set("compartment_id", "ocid1.compartment.oc1..aaaa")
+		d.Set("include_primary_vnic_details", includePrimaryVnicDetails)
+		sync := &InstancesDataSourceCrud{BaseCrud: BaseCrud{D: d},
+			Client:               &core.ComputeClient{BaseClient: newTestBaseClient(server)},
+			VirtualNetworkClient: &core.VirtualNetworkClient{BaseClient: newTestBaseClient(server)}}
+
+		if err := ReadResource(sync); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !includePrimaryVnicDetails {
+			if vnicAttachmentLists != 0 || vnicReads != 0 || d.Get("instances.0.private_ip").(string) != "" {
+				t.Errorf("expected the VNICs not to be looked up by default, got %d lists and %d reads", vnicAttachmentLists, vnicReads)
+			}
+			continue
+		}
+		if vnicAttachmentLists != 1 || vnicReads != 2 {
+			t.Errorf("expected the attachments to be listed once and the VNICs of the running instance to be read, got %d lists and %d reads", vnicAttachmentLists, vnicReads)
+		}
+		if privateIp := d.Get("instances.0.private_ip").(string); privateIp != "10.0.0.2" {
+			t.Errorf("expected the private IP of the primary VNIC, got %q", privateIp)
+		}
+		if subnetId := d.Get("instances.0.subnet_id").(string); subnetId != "ocid1.subnet.oc1..aaaa" {
+			t.Errorf("expected the subnet of the primary VNIC, got %q", subnetId)
+		}
+		if privateIp := d.Get("instances.1.private_ip").(string); privateIp != "" {
+			t.Errorf("expected no private IP for a stopped instance, got %q", privateIp)
+		}
+	}
+}
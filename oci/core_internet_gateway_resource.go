@@ -34,9 +34,10 @@ func InternetGatewayResource() *schema.Resource {
 				Default:  true,
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -167,10 +168,10 @@ func (s *InternetGatewayResourceCrud) Create() error {
 		request.DisplayName = &tmp
 	}
 
-	// TODO: GetOk malfunction with this bool: 'ok' is always the value of the bool
-	// newer versions of terraform support GetOkExists which should resolve this problem
-	enabledTmp := s.D.Get("enabled").(bool)
-	request.IsEnabled = &enabledTmp
+	if enabled, ok := s.D.GetOkExists("enabled"); ok {
+		tmp := enabled.(bool)
+		request.IsEnabled = &tmp
+	}
 
 	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
@@ -181,7 +182,7 @@ func (s *InternetGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateInternetGateway(context.Background(), request)
 	if err != nil {
@@ -198,7 +199,7 @@ func (s *InternetGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.IgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetInternetGateway(context.Background(), request)
 	if err != nil {
@@ -225,10 +226,10 @@ func (s *InternetGatewayResourceCrud) Update() error {
 		request.DisplayName = &tmp
 	}
 
-	// TODO: GetOk malfunction with this bool: 'ok' is always the value of the bool
-	// newer versions of terraform support GetOkExists which should resolve this problem
-	enabledTmp := s.D.Get("enabled").(bool)
-	request.IsEnabled = &enabledTmp
+	if enabled, ok := s.D.GetOkExists("enabled"); ok {
+		tmp := enabled.(bool)
+		request.IsEnabled = &tmp
+	}
 
 	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
@@ -237,7 +238,7 @@ func (s *InternetGatewayResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.IgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInternetGateway(context.Background(), request)
 	if err != nil {
@@ -254,7 +255,7 @@ func (s *InternetGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IgId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteInternetGateway(context.Background(), request)
 	return err
@@ -203,7 +203,7 @@ func (s *IpSecConnectionResourceCrud) Create() error {
 		request.StaticRoutes = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateIPSecConnection(context.Background(), request)
 	if err != nil {
@@ -220,7 +220,7 @@ func (s *IpSecConnectionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.IpscId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetIPSecConnection(context.Background(), request)
 	if err != nil {
@@ -254,7 +254,7 @@ func (s *IpSecConnectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.IpscId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateIPSecConnection(context.Background(), request)
 	if err != nil {
@@ -271,7 +271,7 @@ func (s *IpSecConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IpscId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteIPSecConnection(context.Background(), request)
 	return err
@@ -32,9 +32,10 @@ func LocalPeeringGatewayResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -191,7 +192,7 @@ func (s *LocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error {
 
 		connectRequest.LocalPeeringGatewayId = s.Res.Id
 
-		connectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+		connectRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 		_, err := s.Client.ConnectLocalPeeringGateways(context.Background(), connectRequest)
 		if err != nil {
@@ -260,7 +261,7 @@ func (s *LocalPeeringGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateLocalPeeringGateway(context.Background(), request)
 	if err != nil {
@@ -277,7 +278,7 @@ func (s *LocalPeeringGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.LocalPeeringGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetLocalPeeringGateway(context.Background(), request)
 	if err != nil {
@@ -316,7 +317,7 @@ func (s *LocalPeeringGatewayResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateLocalPeeringGateway(context.Background(), request)
 	if err != nil {
@@ -333,7 +334,7 @@ func (s *LocalPeeringGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LocalPeeringGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteLocalPeeringGateway(context.Background(), request)
 	return err
@@ -392,7 +393,7 @@ func getLocalPeeringGatewayRetryPolicy(timeout time.Duration) *oci_common.RetryP
 	// wait for peering status to not be Pending
 	return &oci_common.RetryPolicy{
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
-			if shouldRetry(response, false, "core", startTime) {
+			if shouldRetry(response, false, "core", startTime, time.Time{}) {
 				return true
 			}
 			if getLocalPeeringGatewayResponse, ok := response.Response.(oci_core.GetLocalPeeringGatewayResponse); ok {
@@ -404,7 +405,7 @@ func getLocalPeeringGatewayRetryPolicy(timeout time.Duration) *oci_common.RetryP
 			return false
 		},
 		NextDuration: func(response oci_common.OCIOperationResponse) time.Duration {
-			return getRetryBackoffDuration(response, false, "core", startTime)
+			return getRetryBackoffDuration(response, false, "core", startTime, time.Time{})
 		},
 		MaximumNumberAttempts: 0,
 	}
@@ -28,9 +28,10 @@ func NatGatewayResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -178,7 +179,7 @@ func (s *NatGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateNatGateway(context.Background(), request)
 	if err != nil {
@@ -195,7 +196,7 @@ func (s *NatGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.NatGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetNatGateway(context.Background(), request)
 	if err != nil {
@@ -234,7 +235,7 @@ func (s *NatGatewayResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.NatGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateNatGateway(context.Background(), request)
 	if err != nil {
@@ -251,7 +252,7 @@ func (s *NatGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NatGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteNatGateway(context.Background(), request)
 	return err
@@ -163,7 +163,7 @@ func (s *PrivateIpResourceCrud) Create() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreatePrivateIp(context.Background(), request)
 	if err != nil {
@@ -180,7 +180,7 @@ func (s *PrivateIpResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PrivateIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetPrivateIp(context.Background(), request)
 	if err != nil {
@@ -224,7 +224,7 @@ func (s *PrivateIpResourceCrud) Update() error {
 		request.VnicId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdatePrivateIp(context.Background(), request)
 	if err != nil {
@@ -241,7 +241,7 @@ func (s *PrivateIpResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PrivateIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeletePrivateIp(context.Background(), request)
 	return err
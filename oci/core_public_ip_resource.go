@@ -211,7 +211,7 @@ func (s *PublicIpResourceCrud) Create() error {
 		request.PrivateIpId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreatePublicIp(context.Background(), request)
 	if err != nil {
@@ -228,7 +228,7 @@ func (s *PublicIpResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetPublicIp(context.Background(), request)
 	if err != nil {
@@ -272,7 +272,7 @@ func (s *PublicIpResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdatePublicIp(context.Background(), request)
 	if err != nil {
@@ -289,7 +289,7 @@ func (s *PublicIpResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeletePublicIp(context.Background(), request)
 	return err
@@ -180,7 +180,7 @@ func (s *RemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection() e
 		connectRequest.PeerRegionName = &tmp
 	}
 
-	connectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	connectRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.ConnectRemotePeeringConnections(context.Background(), connectRequest)
 	if err != nil {
@@ -232,7 +232,7 @@ func (s *RemotePeeringConnectionResourceCrud) Create() error {
 		request.DrgId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateRemotePeeringConnection(context.Background(), request)
 	if err != nil {
@@ -249,7 +249,7 @@ func (s *RemotePeeringConnectionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetRemotePeeringConnection(context.Background(), request)
 	if err != nil {
@@ -271,7 +271,7 @@ func (s *RemotePeeringConnectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateRemotePeeringConnection(context.Background(), request)
 	if err != nil {
@@ -288,7 +288,7 @@ func (s *RemotePeeringConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteRemotePeeringConnection(context.Background(), request)
 	return err
@@ -339,7 +339,7 @@ func getRemotePeeringConnectionRetryPolicy(timeout time.Duration) *oci_common.Re
 	// wait for peering status to not be Pending
 	return &oci_common.RetryPolicy{
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
-			if shouldRetry(response, false, "core", startTime) {
+			if shouldRetry(response, false, "core", startTime, time.Time{}) {
 				return true
 			}
 			if getRemotePeeringConnectionResponse, ok := response.Response.(oci_core.GetRemotePeeringConnectionResponse); ok {
@@ -351,7 +351,7 @@ func getRemotePeeringConnectionRetryPolicy(timeout time.Duration) *oci_common.Re
 			return false
 		},
 		NextDuration: func(response oci_common.OCIOperationResponse) time.Duration {
-			return getRetryBackoffDuration(response, false, "core", startTime)
+			return getRetryBackoffDuration(response, false, "core", startTime, time.Time{})
 		},
 		MaximumNumberAttempts: 0,
 	}
@@ -24,9 +24,10 @@ func RouteTableAttachmentResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("subnet"),
 			},
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -101,11 +102,13 @@ func (s *RouteTableAttachmentResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 	response, err := s.Client.UpdateSubnet(context.Background(), request)
 	if err != nil {
 		return err
 	}
+	// The oci_core_subnet is not changed outside Terraform by this update
+	writtenETags.set(*request.SubnetId, response.Etag)
 
 	s.Res = &response.Subnet
 	return nil
@@ -119,14 +122,15 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 	}
 	request := oci_core.GetSubnetRequest{}
 	request.SubnetId = &subnetId
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 	response, err := s.Client.GetSubnet(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
 	if response.Subnet.RouteTableId == nil || *response.Subnet.RouteTableId != routeTableId {
-		return fmt.Errorf("inconsistent route table id received: %s expected: %s", *response.Subnet.RouteTableId, routeTableId)
+		// The subnet was given another route table outside Terraform
+		return fmt.Errorf("route table attachment %s does not exist", s.D.Id())
 	}
 
 	s.Res = &response.Subnet
@@ -136,7 +140,7 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 func (s *RouteTableAttachmentResourceCrud) Delete() error {
 
 	var subnetIdStr = s.D.Get("subnet_id").(string)
-	var retryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	var retryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	subnetRequest := oci_core.GetSubnetRequest{}
 	subnetRequest.SubnetId = &subnetIdStr
@@ -162,6 +166,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	if err != nil {
 		return err
 	}
+	writtenETags.set(subnetIdStr, updateSubnetResponse.Etag)
 
 	s.Res = &updateSubnetResponse.Subnet
 	return nil
@@ -63,7 +63,7 @@ func (s *DefaultRouteTableResourceCrud) reset() error {
 
 	request.RouteRules = []oci_core.RouteRule{}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateRouteTable(context.Background(), request)
 	if err != nil {
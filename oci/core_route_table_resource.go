@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
@@ -44,6 +45,14 @@ func RouteTableResource() *schema.Resource {
 						"network_entity_id": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: validateOcidResourceType(
+								"internetgateway",
+								"drg",
+								"localpeeringgateway",
+								"natgateway",
+								"privateip",
+								"servicegateway",
+							),
 						},
 
 						// Optional
@@ -62,6 +71,10 @@ func RouteTableResource() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_core.RouteRuleDestinationTypeCidrBlock),
+								string(oci_core.RouteRuleDestinationTypeServiceCidrBlock),
+							}, false),
 						},
 
 						// Computed
@@ -69,9 +82,10 @@ func RouteTableResource() *schema.Resource {
 				},
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -95,6 +109,10 @@ func RouteTableResource() *schema.Resource {
 			},
 
 			// Computed
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -228,7 +246,7 @@ func (s *RouteTableResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateRouteTable(context.Background(), request)
 	if err != nil {
@@ -245,7 +263,7 @@ func (s *RouteTableResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetRouteTable(context.Background(), request)
 	if err != nil {
@@ -253,6 +271,7 @@ func (s *RouteTableResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RouteTable
+	s.setETag(response.Etag)
 	return nil
 }
 
@@ -296,7 +315,9 @@ func (s *RouteTableResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateRouteTable(context.Background(), request)
 	if err != nil {
@@ -304,6 +325,7 @@ func (s *RouteTableResourceCrud) Update() error {
 	}
 
 	s.Res = &response.RouteTable
+	s.setWrittenETag(response.Etag)
 	return nil
 }
 
@@ -313,7 +335,9 @@ func (s *RouteTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteRouteTable(context.Background(), request)
 	return err
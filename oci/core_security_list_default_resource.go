@@ -65,7 +65,7 @@ func (s *DefaultSecurityListResourceCrud) reset() error {
 
 	request.EgressSecurityRules = []oci_core.EgressSecurityRule{}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateSecurityList(context.Background(), request)
 	if err != nil {
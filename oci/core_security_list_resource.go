@@ -346,9 +346,10 @@ func SecurityListResource() *schema.Resource {
 				},
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -372,6 +373,10 @@ func SecurityListResource() *schema.Resource {
 			},
 
 			// Computed
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -516,7 +521,7 @@ func (s *SecurityListResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateSecurityList(context.Background(), request)
 	if err != nil {
@@ -533,7 +538,7 @@ func (s *SecurityListResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetSecurityList(context.Background(), request)
 	if err != nil {
@@ -541,6 +546,7 @@ func (s *SecurityListResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityList
+	s.setETag(response.Etag)
 	return nil
 }
 
@@ -601,7 +607,9 @@ func (s *SecurityListResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateSecurityList(context.Background(), request)
 	if err != nil {
@@ -609,6 +617,7 @@ func (s *SecurityListResourceCrud) Update() error {
 	}
 
 	s.Res = &response.SecurityList
+	s.setWrittenETag(response.Etag)
 	return nil
 }
 
@@ -618,7 +627,9 @@ func (s *SecurityListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteSecurityList(context.Background(), request)
 	return err
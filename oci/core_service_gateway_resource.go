@@ -53,9 +53,10 @@ func ServiceGatewayResource() *schema.Resource {
 				},
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -210,7 +211,7 @@ func (s *ServiceGatewayResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateServiceGateway(context.Background(), request)
 	if err != nil {
@@ -227,7 +228,7 @@ func (s *ServiceGatewayResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ServiceGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetServiceGateway(context.Background(), request)
 	if err != nil {
@@ -283,7 +284,7 @@ func (s *ServiceGatewayResourceCrud) Update() error {
 		request.Services = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateServiceGateway(context.Background(), request)
 	if err != nil {
@@ -300,7 +301,7 @@ func (s *ServiceGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceGatewayId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteServiceGateway(context.Background(), request)
 	return err
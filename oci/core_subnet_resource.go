@@ -4,6 +4,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -28,9 +30,10 @@ func SubnetResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"cidr_block": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCidrBlock,
 			},
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -38,9 +41,10 @@ func SubnetResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("vcn"),
 			},
 
 			// Optional
@@ -66,6 +70,7 @@ func SubnetResource() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				ForceNew:         true,
+				ValidateFunc:     validateDnsLabel,
 				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
 			},
 			"freeform_tags": {
@@ -98,6 +103,10 @@ func SubnetResource() *schema.Resource {
 			},
 
 			// Computed
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,7 +128,55 @@ func SubnetResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: validateSubnetCidrBlockInVcn,
+	}
+}
+
+// validateSubnetCidrBlockInVcn rejects a subnet whose CIDR block falls outside of the CIDR block of its VCN. The check
+// can only be made when the VCN already exists and vcn_id is known at plan time; otherwise the service validates the
+// CIDR block on create. Overlaps with other subnets are left to the service, which knows about the subnets of the VCN
+// in every compartment.
+func validateSubnetCidrBlockInVcn(diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("cidr_block") && !diff.HasChange("vcn_id") {
+		return nil
+	}
+	if !diff.NewValueKnown("cidr_block") || !diff.NewValueKnown("vcn_id") {
+		return nil
+	}
+
+	clients, ok := m.(*OracleClients)
+	if !ok || clients.virtualNetworkClient == nil {
+		return nil
+	}
+
+	cidrBlock := diff.Get("cidr_block").(string)
+	vcnId := diff.Get("vcn_id").(string)
+	if cidrBlock == "" || vcnId == "" {
+		return nil
+	}
+
+	request := oci_core.GetVcnRequest{}
+	request.VcnId = &vcnId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "core")
+
+	response, err := clients.virtualNetworkClient.GetVcn(context.Background(), request)
+	if err != nil {
+		// Leave it to the service to report problems with the VCN
+		log.Printf("[DEBUG] unable to get VCN %s to validate subnet cidr_block: %v", vcnId, err)
+		return nil
+	}
+	if response.CidrBlock == nil {
+		return nil
 	}
+
+	contained, err := cidrBlockContains(*response.CidrBlock, cidrBlock)
+	if err != nil {
+		return err
+	}
+	if !contained {
+		return fmt.Errorf("subnet cidr_block %s is not within the cidr_block %s of VCN %s", cidrBlock, *response.CidrBlock, vcnId)
+	}
+	return nil
 }
 
 func createSubnet(d *schema.ResourceData, m interface{}) error {
@@ -263,7 +320,7 @@ func (s *SubnetResourceCrud) Create() error {
 		request.VcnId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateSubnet(context.Background(), request)
 	if err != nil {
@@ -280,7 +337,7 @@ func (s *SubnetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetSubnet(context.Background(), request)
 	if err != nil {
@@ -288,9 +345,49 @@ func (s *SubnetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Subnet
+	s.setETag(response.Etag)
 	return nil
 }
 
+// ConfirmMissing lists the subnets of the compartment, which the user can list when the subnet is not found because it
+// was deleted rather than because the user is not authorized to access it
+func (s *SubnetResourceCrud) ConfirmMissing() (bool, error) {
+	compartmentId, ok := s.D.GetOkExists("compartment_id")
+	if !ok {
+		// Without a compartment to list, the 404 is taken at its word
+		return true, nil
+	}
+
+	request := oci_core.ListSubnetsRequest{}
+	tmp := compartmentId.(string)
+	request.CompartmentId = &tmp
+
+	vcnId, ok := s.D.GetOkExists("vcn_id")
+	if !ok {
+		return false, nil
+	}
+	tmpVcnId := vcnId.(string)
+	request.VcnId = &tmpVcnId
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	for {
+		response, err := s.Client.ListSubnets(context.Background(), request)
+		if err != nil {
+			return false, err
+		}
+		for _, item := range response.Items {
+			if item.Id != nil && *item.Id == s.D.Id() {
+				return item.LifecycleState == oci_core.SubnetLifecycleStateTerminated, nil
+			}
+		}
+		if response.OpcNextPage == nil {
+			return true, nil
+		}
+		request.Page = response.OpcNextPage
+	}
+}
+
 func (s *SubnetResourceCrud) Update() error {
 	request := oci_core.UpdateSubnetRequest{}
 
@@ -337,7 +434,9 @@ func (s *SubnetResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateSubnet(context.Background(), request)
 	if err != nil {
@@ -345,6 +444,7 @@ func (s *SubnetResourceCrud) Update() error {
 	}
 
 	s.Res = &response.Subnet
+	s.setWrittenETag(response.Etag)
 	return nil
 }
 
@@ -354,7 +454,9 @@ func (s *SubnetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteSubnet(context.Background(), request)
 	return err
@@ -4,9 +4,13 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/oracle/oci-go-sdk/core"
@@ -173,3 +177,48 @@ func TestAccResourceCoreSubnetCreate_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestSubnetResourceDiffValidatesCidrBlockInVcn(t *testing.T) {
+	var vcnRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vcnRequests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ocid1.vcn.oc1..aaaa", "cidrBlock": "10.0.0.0/16", "lifecycleState": "AVAILABLE"}`))
+	}))
+	defer server.Close()
+	client := &core.VirtualNetworkClient{BaseClient: newTestBaseClient(server)}
+
+	for _, testCase := range []struct {
+		cidrBlock     string
+		vcnId         string
+		expectedError string
+		expectVcnRead bool
+	}{
+		{"10.0.1.0/24", "ocid1.vcn.oc1..aaaa", "", true},
+		{"10.1.0.0/24", "ocid1.vcn.oc1..aaaa", "subnet cidr_block 10.1.0.0/24 is not within the cidr_block 10.0.0.0/16 of VCN ocid1.vcn.oc1..aaaa", true},
+		// The VCN is created in the same apply
+		{"10.1.0.0/24", config.UnknownVariableValue, "", false},
+	} {
+		vcnRequests = 0
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"availability_domain": "ad1",
+			"cidr_block":          testCase.cidrBlock,
+			"compartment_id":      "ocid1.compartment.oc1..aaaa",
+			"vcn_id":              testCase.vcnId,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err = SubnetResource().Diff(nil, terraform.NewResourceConfig(raw), &OracleClients{virtualNetworkClient: client})
+		if testCase.expectedError == "" && err != nil {
+			t.Errorf("expected %s in %s to be planned, got %v", testCase.cidrBlock, testCase.vcnId, err)
+		}
+		if testCase.expectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.expectedError)) {
+			t.Errorf("expected %q for %s, got %v", testCase.expectedError, testCase.cidrBlock, err)
+		}
+		if (vcnRequests > 0) != testCase.expectVcnRead {
+			t.Errorf("expected the VCN %s to be read only when it is known, got %d requests", testCase.vcnId, vcnRequests)
+		}
+	}
+}
@@ -23,9 +23,10 @@ func VcnResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cidr_block": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCidrBlock,
 			},
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -51,6 +52,7 @@ func VcnResource() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				ForceNew:         true,
+				ValidateFunc:     validateDnsLabel,
 				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
 			},
 			"freeform_tags": {
@@ -73,6 +75,10 @@ func VcnResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -192,7 +198,7 @@ func (s *VcnResourceCrud) Create() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateVcn(context.Background(), request)
 	if err != nil {
@@ -209,7 +215,7 @@ func (s *VcnResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVcn(context.Background(), request)
 	if err != nil {
@@ -217,9 +223,42 @@ func (s *VcnResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Vcn
+	s.setETag(response.Etag)
 	return nil
 }
 
+// ConfirmMissing lists the VCNs of the compartment, which the user can list when the VCN is not found because it
+// was deleted rather than because the user is not authorized to access it
+func (s *VcnResourceCrud) ConfirmMissing() (bool, error) {
+	compartmentId, ok := s.D.GetOkExists("compartment_id")
+	if !ok {
+		// Without a compartment to list, the 404 is taken at its word
+		return true, nil
+	}
+
+	request := oci_core.ListVcnsRequest{}
+	tmp := compartmentId.(string)
+	request.CompartmentId = &tmp
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	for {
+		response, err := s.Client.ListVcns(context.Background(), request)
+		if err != nil {
+			return false, err
+		}
+		for _, item := range response.Items {
+			if item.Id != nil && *item.Id == s.D.Id() {
+				return item.LifecycleState == oci_core.VcnLifecycleStateTerminated, nil
+			}
+		}
+		if response.OpcNextPage == nil {
+			return true, nil
+		}
+		request.Page = response.OpcNextPage
+	}
+}
+
 func (s *VcnResourceCrud) Update() error {
 	request := oci_core.UpdateVcnRequest{}
 
@@ -243,7 +282,9 @@ func (s *VcnResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVcn(context.Background(), request)
 	if err != nil {
@@ -251,6 +292,7 @@ func (s *VcnResourceCrud) Update() error {
 	}
 
 	s.Res = &response.Vcn
+	s.setWrittenETag(response.Etag)
 	return nil
 }
 
@@ -260,7 +302,9 @@ func (s *VcnResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVcn(context.Background(), request)
 	return err
@@ -330,7 +330,7 @@ func (s *VirtualCircuitResourceCrud) Create() error {
 		request.Type = oci_core.CreateVirtualCircuitDetailsTypeEnum(type_.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateVirtualCircuit(context.Background(), request)
 	if err != nil {
@@ -347,7 +347,7 @@ func (s *VirtualCircuitResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
 	if err != nil {
@@ -427,7 +427,7 @@ func (s *VirtualCircuitResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVirtualCircuit(context.Background(), request)
 	if err != nil {
@@ -445,7 +445,7 @@ func (s *VirtualCircuitResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVirtualCircuit(context.Background(), request)
 	return err
@@ -36,9 +36,10 @@ func VnicAttachmentResource() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						// Required
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateOcidResourceType("subnet"),
 						},
 
 						// Optional
@@ -110,9 +111,10 @@ func VnicAttachmentResource() *schema.Resource {
 				},
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("instance"),
 			},
 
 			// Optional
@@ -264,7 +266,7 @@ func (s *VnicAttachmentResourceCrud) Create() error {
 		request.NicIndex = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.AttachVnic(context.Background(), request)
 	if err != nil {
@@ -307,7 +309,7 @@ func (s *VnicAttachmentResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), request)
 	return err
@@ -319,7 +321,7 @@ func (s *VnicAttachmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VnicAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVnicAttachment(context.Background(), request)
 	if err != nil {
@@ -336,7 +338,7 @@ func (s *VnicAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VnicAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DetachVnic(context.Background(), request)
 	return err
@@ -384,7 +386,7 @@ func (s *VnicAttachmentResourceCrud) SetData() error {
 	// @CODEGEN 1/2018: We need to refresh the vnic details after every refresh.
 	request := oci_core.GetVnicRequest{}
 	request.VnicId = s.Res.VnicId
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.VirtualNetworkClient.GetVnic(context.Background(), request)
 	if err != nil {
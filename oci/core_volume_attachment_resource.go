@@ -36,14 +36,16 @@ func VolumeAttachmentResource() *schema.Resource {
 				}, true),
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("instance"),
 			},
 			"volume_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("volume", "bootvolume"),
 			},
 
 			// Optional
@@ -84,8 +86,9 @@ func VolumeAttachmentResource() *schema.Resource {
 				Computed: true,
 			},
 			"chap_secret": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"chap_username": {
 				Type:     schema.TypeString,
@@ -190,7 +193,13 @@ func (s *VolumeAttachmentResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	if details, ok := request.AttachVolumeDetails.(oci_core.AttachParavirtualizedVolumeDetails); ok {
+		if err := s.validateParavirtualizedInstanceShape(details.InstanceId); err != nil {
+			return err
+		}
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.AttachVolume(context.Background(), request)
 	if err != nil {
@@ -207,7 +216,7 @@ func (s *VolumeAttachmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVolumeAttachment(context.Background(), request)
 	if err != nil {
@@ -224,7 +233,7 @@ func (s *VolumeAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeAttachmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DetachVolume(context.Background(), request)
 	return err
@@ -416,3 +425,26 @@ func (s *VolumeAttachmentResourceCrud) populateTopLevelPolymorphicAttachVolumeRe
 	}
 	return nil
 }
+
+// Paravirtualized attachments are only available on virtual machine instances, so fail early for bare metal shapes
+// rather than waiting on the service to reject the attachment.
+func (s *VolumeAttachmentResourceCrud) validateParavirtualizedInstanceShape(instanceId *string) error {
+	if instanceId == nil {
+		return nil
+	}
+
+	request := oci_core.GetInstanceRequest{InstanceId: instanceId}
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetInstance(context.Background(), request)
+	if err != nil {
+		log.Printf("[WARN] Could not get instance %s to validate its shape: %v", *instanceId, err)
+		return nil
+	}
+
+	if response.Shape != nil && strings.HasPrefix(*response.Shape, "BM.") {
+		return fmt.Errorf("attachment_type 'paravirtualized' is only supported for virtual machine instances, instance %s has shape %s", *instanceId, *response.Shape)
+	}
+
+	return nil
+}
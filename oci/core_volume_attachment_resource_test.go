@@ -328,3 +328,17 @@ func (s *ResourceCoreVolumeAttachmentTestSuite) TestResourceCoreVolumeAttachment
 func TestResourceCoreVolumeAttachmentTestSuite(t *testing.T) {
 	suite.Run(t, new(ResourceCoreVolumeAttachmentTestSuite))
 }
+
+func TestVolumeAttachmentResourceVolumeIdValidation(t *testing.T) {
+	validateFunc := VolumeAttachmentResource().Schema["volume_id"].ValidateFunc
+
+	for _, volumeId := range []string{"ocid1.volume.oc1.phx.aaaaaaaa", "ocid1.bootvolume.oc1.phx.aaaaaaaa"} {
+		if _, errs := validateFunc(volumeId, "volume_id"); len(errs) != 0 {
+			t.Errorf("expected %q to be a valid attachment target, got %v", volumeId, errs)
+		}
+	}
+
+	if _, errs := validateFunc("ocid1.instance.oc1.phx.aaaaaaaa", "volume_id"); len(errs) == 0 {
+		t.Errorf("expected an instance OCID to be rejected")
+	}
+}
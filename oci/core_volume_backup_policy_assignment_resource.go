@@ -92,7 +92,7 @@ func (s *VolumeBackupPolicyAssignmentResourceCrud) Create() error {
 		request.PolicyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateVolumeBackupPolicyAssignment(context.Background(), request)
 	if err != nil {
@@ -109,7 +109,7 @@ func (s *VolumeBackupPolicyAssignmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PolicyAssignmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVolumeBackupPolicyAssignment(context.Background(), request)
 	if err != nil {
@@ -126,7 +126,7 @@ func (s *VolumeBackupPolicyAssignmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyAssignmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(context.Background(), request)
 	return err
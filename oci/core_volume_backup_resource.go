@@ -30,6 +30,7 @@ func VolumeBackupResource() *schema.Resource {
 				ForceNew:      true,
 				Computed:      true,
 				ConflictsWith: []string{"source_details"},
+				ValidateFunc:  validateOcidResourceType("volume"),
 			},
 			"source_details": {
 				Type:          schema.TypeList,
@@ -296,7 +297,7 @@ func (s *VolumeBackupResourceCrud) CreateVolumeBackup() error {
 		request.VolumeId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateVolumeBackup(context.Background(), request)
 	if err != nil {
@@ -313,7 +314,7 @@ func (s *VolumeBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVolumeBackup(context.Background(), request)
 	if err != nil {
@@ -347,7 +348,7 @@ func (s *VolumeBackupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolumeBackup(context.Background(), request)
 	if err != nil {
@@ -364,7 +365,7 @@ func (s *VolumeBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeBackup(context.Background(), request)
 	return err
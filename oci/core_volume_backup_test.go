@@ -24,6 +24,8 @@ var (
 	volumeBackupDataSourceRepresentation = map[string]interface{}{
 		"compartment_id": Representation{repType: Required, create: `${var.compartment_id}`},
 		"display_name":   Representation{repType: Optional, create: `displayName`, update: `displayName2`},
+		"sort_by":        Representation{repType: Optional, create: `TIMECREATED`},
+		"sort_order":     Representation{repType: Optional, create: `DESC`},
 		"state":          Representation{repType: Optional, create: `AVAILABLE`},
 		"volume_id":      Representation{repType: Optional, create: `${oci_core_volume.test_volume.id}`},
 		"filter":         RepresentationGroup{Required, volumeBackupDataSourceFilterRepresentation}}
@@ -153,6 +155,8 @@ func TestCoreVolumeBackupResource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
 					resource.TestCheckResourceAttr(datasourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(datasourceName, "sort_by", "TIMECREATED"),
+					resource.TestCheckResourceAttr(datasourceName, "sort_order", "DESC"),
 					resource.TestCheckResourceAttr(datasourceName, "state", "AVAILABLE"),
 					resource.TestCheckResourceAttrSet(datasourceName, "volume_id"),
 
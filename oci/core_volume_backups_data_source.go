@@ -23,6 +23,14 @@ func VolumeBackupsDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"source_volume_backup_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -85,6 +93,16 @@ func (s *VolumeBackupsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		tmp := sortBy.(string)
+		request.SortBy = oci_core.ListVolumeBackupsSortByEnum(tmp)
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		tmp := sortOrder.(string)
+		request.SortOrder = oci_core.ListVolumeBackupsSortOrderEnum(tmp)
+	}
+
 	if sourceVolumeBackupId, ok := s.D.GetOkExists("source_volume_backup_id"); ok {
 		tmp := sourceVolumeBackupId.(string)
 		request.SourceVolumeBackupId = &tmp
@@ -205,7 +205,7 @@ func (s *VolumeGroupBackupResourceCrud) Create() error {
 		request.VolumeGroupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateVolumeGroupBackup(context.Background(), request)
 	if err != nil {
@@ -222,7 +222,7 @@ func (s *VolumeGroupBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVolumeGroupBackup(context.Background(), request)
 	if err != nil {
@@ -256,7 +256,7 @@ func (s *VolumeGroupBackupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolumeGroupBackup(context.Background(), request)
 	if err != nil {
@@ -273,7 +273,7 @@ func (s *VolumeGroupBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeGroupBackup(context.Background(), request)
 	return err
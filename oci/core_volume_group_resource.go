@@ -247,7 +247,7 @@ func (s *VolumeGroupResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateVolumeGroup(context.Background(), request)
 	if err != nil {
@@ -264,7 +264,7 @@ func (s *VolumeGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVolumeGroup(context.Background(), request)
 	if err != nil {
@@ -310,7 +310,7 @@ func (s *VolumeGroupResourceCrud) Update() error {
 		request.VolumeIds = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolumeGroup(context.Background(), request)
 	if err != nil {
@@ -327,7 +327,7 @@ func (s *VolumeGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeGroup(context.Background(), request)
 	return err
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -142,6 +143,8 @@ func VolumeResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		// A volume can only be resized to a larger size in place, so a smaller 'size_in_gbs' results in a new volume
+		CustomizeDiff: customdiff.ForceNewIfChange("size_in_gbs", int64StringDecreased),
 	}
 }
 
@@ -305,7 +308,7 @@ func (s *VolumeResourceCrud) Create() error {
 		request.VolumeBackupId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.CreateVolume(context.Background(), request)
 	if err != nil {
@@ -322,7 +325,7 @@ func (s *VolumeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.GetVolume(context.Background(), request)
 	if err != nil {
@@ -362,7 +365,7 @@ func (s *VolumeResourceCrud) Update() error {
 		tmp := s.D.Get("kms_key_id").(string)
 		keyUpdateRequest.KmsKeyId = &tmp
 
-		keyUpdateRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+		keyUpdateRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 		_, err := s.Client.UpdateVolumeKmsKey(context.Background(), keyUpdateRequest)
 		if err != nil {
@@ -382,7 +385,7 @@ func (s *VolumeResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolume(context.Background(), request)
 	if err != nil {
@@ -399,7 +402,7 @@ func (s *VolumeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolume(context.Background(), request)
 	return err
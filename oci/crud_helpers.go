@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
@@ -33,17 +34,103 @@ var (
 
 const (
 	FAILED = "FAILED"
+
+	notAuthorizedOrNotFoundCode = "NotAuthorizedOrNotFound"
 )
 
+// configureDefaultTimeouts returns a ConfigureFunc that sets the timeouts of the given resources from the
+// default_timeout_minutes provider block before calling configfn. Every provider has its own resources, so the
+// timeouts of one provider, e.g. an aliased one, do not change those of the others.
+func configureDefaultTimeouts(resources map[string]*schema.Resource, configfn schema.ConfigureFunc) schema.ConfigureFunc {
+	return func(d *schema.ResourceData) (interface{}, error) {
+		if defaultTimeouts, ok := d.GetOkExists(defaultTimeoutMinutesAttrName); ok {
+			if tmpList := defaultTimeouts.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+				setDefaultTimeouts(resources, tmpList[0].(map[string]interface{}))
+			}
+		}
+		return configfn(d)
+	}
+}
+
+// setDefaultTimeouts replaces the timeouts of the resources that support timeouts with the minutes of each
+// operation set in the default_timeout_minutes provider block. The resources get a copy of their timeouts, since
+// many of them share DefaultTimeout. The timeouts are read when Terraform plans the resources, after the provider
+// is configured.
+func setDefaultTimeouts(resources map[string]*schema.Resource, minutes map[string]interface{}) {
+	for _, resource := range resources {
+		if resource.Timeouts == nil {
+			continue
+		}
+		timeouts := *resource.Timeouts
+		if value, ok := minutes["create"].(int); ok && value > 0 {
+			timeout := time.Duration(value) * time.Minute
+			timeouts.Create = &timeout
+		}
+		if value, ok := minutes["update"].(int); ok && value > 0 {
+			timeout := time.Duration(value) * time.Minute
+			timeouts.Update = &timeout
+		}
+		if value, ok := minutes["delete"].(int); ok && value > 0 {
+			timeout := time.Duration(value) * time.Minute
+			timeouts.Delete = &timeout
+		}
+		resource.Timeouts = &timeouts
+	}
+}
+
 type BaseCrud struct {
 	D     *schema.ResourceData
 	Mutex *sync.Mutex
+	// operationDeadline is when the timeout of the Create, Update or Delete in progress expires
+	operationDeadline time.Time
 }
 
 func (s *BaseCrud) VoidState() {
 	s.D.SetId("")
 }
 
+func (s *BaseCrud) setOperationDeadline(deadline time.Time) {
+	s.operationDeadline = deadline
+}
+
+// getRetryPolicy returns the retry policy of a request made by the crud. During a Create, Update or Delete, requests
+// rejected because a resource is transitioning are retried until the timeout of the operation expires.
+func (s *BaseCrud) getRetryPolicy(disableNotFoundRetries bool, service string) *oci_common.RetryPolicy {
+	return getOperationRetryPolicy(disableNotFoundRetries, service, s.operationDeadline)
+}
+
+// setETag stores the ETag of the resource returned by a Get in the etag attribute, so that the next Update or Delete
+// is made only if the resource has not changed since it was refreshed
+func (s *BaseCrud) setETag(etag *string) {
+	s.D.Set("etag", etag)
+}
+
+// setWrittenETag stores the ETag of the resource returned by an Update made by this provider
+func (s *BaseCrud) setWrittenETag(etag *string) {
+	s.setETag(etag)
+	writtenETags.set(s.D.Id(), etag)
+}
+
+// getIfMatch returns the ETag of the resource when it was last refreshed, unless this provider changed the resource
+// since, e.g. with an oci_core_route_table_attachment updating its subnet, or nil to update it unconditionally
+func (s *BaseCrud) getIfMatch() *string {
+	if etag := writtenETags.get(s.D.Id()); etag != nil {
+		return etag
+	}
+	if etag, ok := s.D.GetOk("etag"); ok {
+		tmp := etag.(string)
+		return &tmp
+	}
+	return nil
+}
+
+func (s *BaseCrud) resourceDataId() string {
+	if s.D == nil {
+		return ""
+	}
+	return s.D.Id()
+}
+
 // Default implementation, used in conjunction with State()
 func (s *BaseCrud) setState(sync StatefulResource) error {
 	// Pseudo code:
@@ -86,19 +173,215 @@ func (s *BaseCrud) State() string {
 	return ""
 }
 
+// writtenETags remembers the ETag returned by the last update this provider made to each resource, so that a resource
+// updated by another resource of the configuration after the state was refreshed is not taken as changed outside Terraform
+var writtenETags = &writtenETagTracker{etags: map[string]string{}}
+
+type writtenETagTracker struct {
+	mutex sync.Mutex
+	etags map[string]string
+}
+
+func (t *writtenETagTracker) set(id string, etag *string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if etag == nil || *etag == "" {
+		delete(t.etags, id)
+		return
+	}
+	t.etags[id] = *etag
+}
+
+func (t *writtenETagTracker) get(id string) *string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if etag, ok := t.etags[id]; ok {
+		return &etag
+	}
+	return nil
+}
+
+// createdResources remembers when this provider created each resource, so that a resource that is not visible yet
+// right after it was created is not treated as missing. IAM and object storage in particular are eventually
+// consistent, and reading a new resource may return 404 for a while.
+var createdResources = &createdResourceTracker{createdAt: map[string]time.Time{}}
+
+type createdResourceTracker struct {
+	mutex     sync.Mutex
+	createdAt map[string]time.Time
+}
+
+func (t *createdResourceTracker) add(id string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.createdAt[id] = time.Now()
+}
+
+func (t *createdResourceTracker) remove(id string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.createdAt, id)
+}
+
+// gracePeriodRemaining returns how much longer a not found error for the resource should be retried
+func (t *createdResourceTracker) gracePeriodRemaining(id string) time.Duration {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	createdAt, ok := t.createdAt[id]
+	if !ok {
+		return 0
+	}
+	remaining := createdNotFoundGracePeriod - getElapsedRetryDuration(createdAt)
+	if remaining <= 0 {
+		delete(t.createdAt, id)
+	}
+	return remaining
+}
+
+// getWithCreatedGracePeriod calls Get again while it returns 404 for a resource that was created less than
+// createdNotFoundGracePeriod ago
+func getWithCreatedGracePeriod(sync ResourceFetcher) error {
+	identifier, identified := sync.(interface {
+		resourceDataId() string
+	})
+
+	for {
+		e := sync.Get()
+		if e == nil || !identified || !isNotFoundError(e) {
+			return e
+		}
+
+		remaining := createdResources.gracePeriodRemaining(identifier.resourceDataId())
+		if remaining <= 0 {
+			return e
+		}
+		if remaining > createdNotFoundRetryInterval {
+			remaining = createdNotFoundRetryInterval
+		}
+		log.Printf("[DEBUG] %s was created recently and is not found yet, retrying in %v", identifier.resourceDataId(), remaining)
+		time.Sleep(remaining)
+	}
+}
+
+func isNotFoundError(err error) bool {
+	serviceError, ok := oci_common.IsServiceError(err)
+	return ok && serviceError.GetHTTPStatusCode() == 404
+}
+
+// handleServiceError adds the operation and the resource it was made for to a service error, so that a failed
+// request can be identified and reported with its opc-request-id. Other errors are returned unchanged.
+func handleServiceError(sync interface{}, operation string, err error) error {
+	serviceError, ok := oci_common.IsServiceError(err)
+	if !ok {
+		return err
+	}
+
+	if isUnconfirmedNotFoundError(err) {
+		return fmt.Errorf("%s of %s failed with HTTP %d %s: %s\nThe resource may have been deleted outside Terraform, or the user may not be authorized to access it. "+
+			"If it was deleted, remove it from the state with terraform state rm\nOpc request id: %s", operation, getCrudResourceName(sync),
+			serviceError.GetHTTPStatusCode(), serviceError.GetCode(), serviceError.GetMessage(), serviceError.GetOpcRequestID())
+	}
+
+	if serviceError.GetHTTPStatusCode() == 412 {
+		return fmt.Errorf("%s of %s failed because the resource was changed outside Terraform since it was last refreshed, refresh and try again\nOpc request id: %s",
+			operation, getCrudResourceName(sync), serviceError.GetOpcRequestID())
+	}
+
+	return fmt.Errorf("%s of %s failed with HTTP %d %s: %s\nOpc request id: %s", operation, getCrudResourceName(sync),
+		serviceError.GetHTTPStatusCode(), serviceError.GetCode(), serviceError.GetMessage(), serviceError.GetOpcRequestID())
+}
+
+// getCrudResourceName returns the name of the resource or data source that a crud is for, e.g. Vcn for *VcnResourceCrud
+func getCrudResourceName(sync interface{}) string {
+	crudType := reflect.TypeOf(sync)
+	if crudType.Kind() == reflect.Ptr {
+		crudType = crudType.Elem()
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(crudType.Name(), "Crud"), "Resource")
+}
+
+// isMissingResourceError returns true for the errors returned when a resource, or the resource it belongs to, no longer
+// exists, e.g. because it was deleted outside Terraform. This includes a 404 NotAuthorizedOrNotFound, which the
+// services return for most deleted resources, see isUnconfirmedNotFoundError.
+func isMissingResourceError(err error) bool {
+	if serviceError, ok := oci_common.IsServiceError(err); ok {
+		return serviceError.GetHTTPStatusCode() == 404
+	}
+	return strings.Contains(err.Error(), "does not exist") ||
+		strings.Contains(err.Error(), " not present in ") ||
+		strings.Contains(err.Error(), "not found") ||
+		(strings.Contains(err.Error(), "Load balancer") && strings.Contains(err.Error(), " has no "))
+}
+
+// isUnconfirmedNotFoundError returns true for the 404 NotAuthorizedOrNotFound errors, which do not tell a resource that
+// was deleted apart from one the user is not authorized to access
+func isUnconfirmedNotFoundError(err error) bool {
+	serviceError, ok := oci_common.IsServiceError(err)
+	return ok && serviceError.GetHTTPStatusCode() == 404 && serviceError.GetCode() == notAuthorizedOrNotFoundCode
+}
+
+// handleMissingResourceError removes a resource that no longer exists from the state and clears the error, so that
+// Terraform plans to create it again instead of failing. On a 404 NotAuthorizedOrNotFound, a resource that can check
+// whether it was deleted is kept in the state when it is still listed, since the user is then only not authorized to
+// read it.
 func handleMissingResourceError(sync ResourceVoider, err *error) {
+	if err == nil || *err == nil || !isMissingResourceError(*err) {
+		return
+	}
 
-	if err != nil {
-		if strings.Contains((*err).Error(), "does not exist") ||
-			strings.Contains((*err).Error(), " not present in ") ||
-			strings.Contains((*err).Error(), "not found") ||
-			(strings.Contains((*err).Error(), "Load balancer") && strings.Contains((*err).Error(), " has no ")) {
+	if confirmer, ok := sync.(MissingResourceConfirmer); ok && isUnconfirmedNotFoundError(*err) {
+		confirmed, confirmErr := confirmer.ConfirmMissing()
+		if confirmErr != nil {
+			log.Printf("[DEBUG] Could not confirm that %s was deleted, treating it as deleted: %v", getCrudResourceName(sync), confirmErr)
+		} else if !confirmed {
+			return
+		}
+	}
 
-			log.Println("[DEBUG] Object does not exist, voiding resource and nullifying error")
-			sync.VoidState()
-			*err = nil
+	log.Println("[DEBUG] Object does not exist, voiding resource and nullifying error")
+	sync.VoidState()
+	*err = nil
+}
+
+// buildCompositeId joins the names and ids of a resource and its parents into an id that can be imported, e.g.
+// buildCompositeId("loadBalancers", loadBalancerId, "listeners", name) returns "loadBalancers/{loadBalancerId}/listeners/{name}".
+// The ids are escaped, so that they may contain "/".
+func buildCompositeId(namesAndIds ...string) string {
+	parts := make([]string, len(namesAndIds))
+	for i, part := range namesAndIds {
+		if i%2 == 1 {
+			part = url.PathEscape(part)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, "/")
+}
+
+// parseCompositeId returns the ids of a composite id built by buildCompositeId with the same names
+func parseCompositeId(compositeId string, names ...string) ([]string, error) {
+	parts := strings.Split(compositeId, "/")
+	if len(parts) != 2*len(names) {
+		return nil, fmt.Errorf("illegal compositeId %s encountered, expected %s", compositeId, getCompositeIdFormat(names))
+	}
+
+	ids := make([]string, len(names))
+	for i, name := range names {
+		id, err := url.PathUnescape(parts[2*i+1])
+		if parts[2*i] != name || id == "" || err != nil {
+			return nil, fmt.Errorf("illegal compositeId %s encountered, expected %s", compositeId, getCompositeIdFormat(names))
 		}
+		ids[i] = id
 	}
+	return ids, nil
+}
+
+func getCompositeIdFormat(names []string) string {
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s/{%s}", name, name)
+	}
+	return strings.Join(parts, "/")
 }
 
 func LoadBalancerResourceID(res interface{}, workReq *oci_load_balancer.WorkRequest) (id *string, workReqSucceeded bool) {
@@ -142,6 +425,17 @@ func LoadBalancerResourceID(res interface{}, workReq *oci_load_balancer.WorkRequ
 	return nil, false
 }
 
+// The states of the work requests that load balancer resources wait on
+var loadBalancerWorkRequestPendingStates = []string{
+	string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
+	string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
+}
+
+var loadBalancerWorkRequestTargetStates = []string{
+	string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
+	string(oci_load_balancer.WorkRequestLifecycleStateFailed),
+}
+
 func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy) (id string, stillWorking bool, err error) {
 	// NOTE: if the id is for a work request, refresh its state and loadBalancerID.
 	if wr != nil && wr.Id != nil {
@@ -159,7 +453,7 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 				return "", false, nil
 			}
 			if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-				return "", false, fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+				return "", false, getLoadBalancerWorkRequestError(wr)
 			}
 		}
 		return "", true, nil
@@ -167,23 +461,42 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 	return id, false, nil
 }
 
+// LoadBalancerWaitForWorkRequestId waits for the work request started by a load balancer operation, and returns it in
+// its final state. Resources keep the work request to tell whether they were created.
+func LoadBalancerWaitForWorkRequestId(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, workRequestId *string, retryPolicy *oci_common.RetryPolicy) (*oci_load_balancer.WorkRequest, error) {
+	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
+	getWorkRequestRequest.WorkRequestId = workRequestId
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
+	workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	wr := &workRequestResponse.WorkRequest
+	return wr, LoadBalancerWaitForWorkRequest(client, d, wr, retryPolicy)
+}
+
+// LoadBalancerWaitForWorkRequest polls the work request until it succeeds or fails, and updates it with the last state
 func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy) error {
+	progress := newWaitProgressLogger(fmt.Sprintf("load balancer work request %s", *wr.Id))
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-			string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-		},
-		Target: []string{
-			string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-			string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-		},
+		Pending: loadBalancerWorkRequestPendingStates,
+		Target:  loadBalancerWorkRequestTargetStates,
 		Refresh: func() (interface{}, string, error) {
 			getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 			getWorkRequestRequest.WorkRequestId = wr.Id
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
 			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-			wr = &workRequestResponse.WorkRequest
-			return wr, string(wr.LifecycleState), err
+			if err != nil {
+				return nil, "", err
+			}
+			*wr = workRequestResponse.WorkRequest
+			if wr.Message != nil && *wr.Message != "" {
+				progress.log(fmt.Sprintf("%s, %s", wr.LifecycleState, *wr.Message))
+			} else {
+				progress.log(string(wr.LifecycleState))
+			}
+			return wr, string(wr.LifecycleState), nil
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -193,19 +506,42 @@ func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 	}
 
 	if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-		return fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+		return getLoadBalancerWorkRequestError(wr)
 	}
 	return nil
 }
 
-func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error {
-	if e := sync.Create(); e != nil {
-		return e
+// getLoadBalancerWorkRequestError describes why a work request failed with its error details, or its last message
+// when the service did not return any
+func getLoadBalancerWorkRequestError(wr *oci_load_balancer.WorkRequest) error {
+	var details []string
+	for _, errorDetail := range wr.ErrorDetails {
+		if errorDetail.Message != nil {
+			details = append(details, fmt.Sprintf("%s: %s", errorDetail.ErrorCode, *errorDetail.Message))
+		}
+	}
+	if len(details) == 0 && wr.Message != nil {
+		details = append(details, *wr.Message)
 	}
 
-	// ID is required for state refresh
-	d.SetId(sync.ID())
+	workRequestType := ""
+	if wr.Type != nil {
+		workRequestType = *wr.Type + " "
+	}
+	workRequestId := ""
+	if wr.Id != nil {
+		workRequestId = *wr.Id
+	}
+	return fmt.Errorf("%swork request %s failed: %s", workRequestType, workRequestId, strings.Join(details, "; "))
+}
+
+func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error {
+	return retryFailedCreate(sync, func() error {
+		return createDBSystemResource(d, sync)
+	})
+}
 
+func createDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error {
 	var timeout time.Duration
 	shape := d.Get("shape")
 	timeout = d.Timeout(schema.TimeoutCreate)
@@ -216,10 +552,18 @@ func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error
 			timeout = time.Duration(2) * time.Hour
 		}
 	}
-	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefresh(stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
-			return e
-		}
+
+	setOperationTimeout(sync, timeout)
+	if e := sync.Create(); e != nil {
+		return handleServiceError(sync, "Create", e)
+	}
+
+	// ID is required for state refresh
+	d.SetId(sync.ID())
+	createdResources.add(d.Id())
+
+	if e := waitForCreatedState(sync, timeout); e != nil {
+		return e
 	}
 
 	d.SetId(sync.ID())
@@ -242,22 +586,27 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 		}
 	}
 
+	return retryFailedCreate(sync, func() error {
+		return createResource(d, sync)
+	})
+}
+
+func createResource(d *schema.ResourceData, sync ResourceCreator) error {
+	setOperationTimeout(sync, d.Timeout(schema.TimeoutCreate))
 	if e := sync.Create(); e != nil {
-		return e
+		return handleServiceError(sync, "Create", e)
 	}
 
 	// ID is required for state refresh
 	d.SetId(sync.ID())
+	createdResources.add(d.Id())
 
-	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
-			if stateful.State() == FAILED {
-				// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
-				// TODO: automatic retry on WorkRequestFailed
-				sync.VoidState()
-			}
-			return e
+	if e := waitForCreatedState(sync, d.Timeout(schema.TimeoutCreate)); e != nil {
+		if stateful, ok := sync.(StatefulResource); ok && stateful.State() == FAILED {
+			// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
+			sync.VoidState()
 		}
+		return e
 	}
 
 	d.SetId(sync.ID())
@@ -272,11 +621,36 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 	return nil
 }
 
+// setOperationTimeout lets the requests of the Create, Update or Delete that is starting retry conflict errors until
+// its timeout expires
+func setOperationTimeout(sync interface{}, timeout time.Duration) {
+	if operation, ok := sync.(interface {
+		setOperationDeadline(deadline time.Time)
+	}); ok {
+		operation.setOperationDeadline(time.Now().Add(timeout))
+	}
+}
+
+// retryFailedCreate calls create again when it fails for a reason the resource reports as transient, up to
+// configuredCreateFailureRetries times, after deleting what the failed attempt left behind
+func retryFailedCreate(sync ResourceCreator, create func() error) error {
+	e := create()
+	retryable, ok := sync.(RetryableCreateFailure)
+	for attempt := uint(1); e != nil && ok && attempt <= configuredCreateFailureRetries && retryable.IsCreateFailureRetryable(); attempt++ {
+		log.Printf("[WARN] creation of %s failed, requesting it again (retry %d of %d): %v", getCrudResourceName(sync), attempt, configuredCreateFailureRetries, e)
+		if cleanUpErr := retryable.CleanUpFailedCreate(); cleanUpErr != nil {
+			return fmt.Errorf("%v\nthe failed %s could not be deleted before creating it again: %v", e, getCrudResourceName(sync), cleanUpErr)
+		}
+		e = create()
+	}
+	return e
+}
+
 func ReadResource(sync ResourceReader) error {
-	if e := sync.Get(); e != nil {
+	if e := getWithCreatedGracePeriod(sync); e != nil {
 		log.Printf("ERROR IN GET: %v\n", e.Error())
 		handleMissingResourceError(sync, &e)
-		return e
+		return handleServiceError(sync, "Read", e)
 	}
 
 	if e := sync.SetData(); e != nil {
@@ -305,15 +679,14 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 	}
 
 	d.Partial(true)
+	setOperationTimeout(sync, d.Timeout(schema.TimeoutUpdate))
 	if e := sync.Update(); e != nil {
-		return e
+		return handleServiceError(sync, "Update", e)
 	}
 	d.Partial(false)
 
-	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
-			return e
-		}
+	if e := waitForUpdatedState(sync, d.Timeout(schema.TimeoutUpdate)); e != nil {
+		return e
 	}
 
 	if e := sync.SetData(); e != nil {
@@ -335,16 +708,15 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
 		}
 	}
 
+	createdResources.remove(d.Id())
+	setOperationTimeout(sync, d.Timeout(schema.TimeoutDelete))
 	if e := sync.Delete(); e != nil {
 		handleMissingResourceError(sync, &e)
-		return e
+		return handleServiceError(sync, "Delete", e)
 	}
 
-	if stateful, ok := sync.(StatefullyDeletedResource); ok {
-		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
-			handleMissingResourceError(sync, &e)
-			return e
-		}
+	if e := waitForDeletedState(sync, d.Timeout(schema.TimeoutDelete)); e != nil {
+		return e
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
@@ -358,7 +730,7 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
 
 func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
 	return func() (res interface{}, s string, e error) {
-		if e = sync.Get(); e != nil {
+		if e = getWithCreatedGracePeriod(sync); e != nil {
 			return nil, "", e
 		}
 		// We don't set all the state here, because not found errors are handled elsewhere.
@@ -376,14 +748,27 @@ func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
 // It does not set state from that refreshed state.
 func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
 	// TODO: try to move this onto sync
+	progress := newWaitProgressLogger(fmt.Sprintf("%s %s", getCrudResourceName(sync), operationName))
+	refresh := stateRefreshFunc(sync)
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFunc(sync),
+		Refresh: func() (interface{}, string, error) {
+			res, state, e := refresh()
+			if e == nil {
+				progress.log(state)
+			}
+			return res, state, e
+		},
 		Timeout: timeout,
 	}
 
 	if _, e := stateConf.WaitForState(); e != nil {
+		// A resource that is not found once its deletion was accepted has been deleted
+		if operationName == "deletion" && isNotFoundError(e) {
+			sync.VoidState()
+			return nil
+		}
 		handleMissingResourceError(sync, &e)
 		return e
 	}
@@ -395,12 +780,30 @@ func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 	return nil
 }
 
-func FilterMissingResourceError(sync ResourceVoider, err *error) {
-	if err != nil && strings.Contains((*err).Error(), "does not exist") {
-		log.Println("[DEBUG] Object does not exist, voiding resource and nullifying error")
-		sync.VoidState()
-		*err = nil
+// waitForCreatedState waits for a resource that is created asynchronously to reach one of its CreatedTarget states.
+// Resources that are created synchronously are not waited for.
+func waitForCreatedState(sync interface{}, timeout time.Duration) error {
+	if stateful, ok := sync.(StatefullyCreatedResource); ok {
+		return waitForStateRefresh(stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget())
+	}
+	return nil
+}
+
+// waitForUpdatedState waits for a resource that is updated asynchronously to reach one of its UpdatedTarget states
+func waitForUpdatedState(sync interface{}, timeout time.Duration) error {
+	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
+		return waitForStateRefresh(stateful, timeout, "update", stateful.UpdatedPending(), stateful.UpdatedTarget())
+	}
+	return nil
+}
+
+// waitForDeletedState waits for a resource that is deleted asynchronously to reach one of its DeletedTarget states. A
+// resource that disappears while waiting is deleted, so it is not an error.
+func waitForDeletedState(sync interface{}, timeout time.Duration) error {
+	if stateful, ok := sync.(StatefullyDeletedResource); ok {
+		return waitForStateRefresh(stateful, timeout, "deletion", stateful.DeletedPending(), stateful.DeletedTarget())
 	}
+	return nil
 }
 
 func EqualIgnoreCaseSuppressDiff(key string, old string, new string, d *schema.ResourceData) bool {
@@ -3,10 +3,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
 )
 
 type TestResource struct {
@@ -84,3 +91,466 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 		return
 	}
 }
+
+type noopRequestSigner struct{}
+
+func (s noopRequestSigner) Sign(r *http.Request) error {
+	return nil
+}
+
+// newTestBaseClient returns a client that sends unsigned requests to the test server
+func newTestBaseClient(server *httptest.Server) oci_common.BaseClient {
+	return oci_common.BaseClient{HTTPClient: http.DefaultClient, Signer: noopRequestSigner{}, UserAgent: "test", Host: server.URL}
+}
+
+func TestHandleServiceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("opc-request-id", "fake-request-id")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code": "InvalidParameter", "message": "Invalid cidrBlock."}`))
+	}))
+	defer server.Close()
+
+	client := newTestBaseClient(server)
+	request, _ := http.NewRequest(http.MethodPost, "/vcns", nil)
+	_, serviceError := client.Call(context.Background(), request)
+	if _, ok := oci_common.IsServiceError(serviceError); !ok {
+		t.Fatalf("expected a service error, got %v", serviceError)
+	}
+
+	err := handleServiceError(&VcnResourceCrud{}, "Create", serviceError)
+	expected := "Create of Vcn failed with HTTP 400 InvalidParameter: Invalid cidrBlock.\nOpc request id: fake-request-id"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+
+	otherError := fmt.Errorf("timeout while waiting for state")
+	if err := handleServiceError(&VcnResourceCrud{}, "Create", otherError); err != otherError {
+		t.Errorf("expected errors that are not service errors to be returned unchanged, got %q", err)
+	}
+
+	if err := handleServiceError(&VcnResourceCrud{}, "Delete", nil); err != nil {
+		t.Errorf("expected no error, got %q", err)
+	}
+}
+
+func TestLoadBalancerWaitForWorkRequestId(t *testing.T) {
+	getCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		getCount++
+		state := "IN_PROGRESS"
+		if getCount > 1 {
+			state = "FAILED"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"id": "ocid1.loadbalancerworkrequest.oc1..aaaa", "loadBalancerId": "ocid1.loadbalancer.oc1..aaaa",
+			"type": "CreateListener", "lifecycleState": "%s", "message": "Listener creation failed",
+			"errorDetails": [{"errorCode": "BAD_INPUT", "message": "Backend set does not exist"}]}`, state)))
+	}))
+	defer server.Close()
+
+	client := oci_load_balancer.LoadBalancerClient{BaseClient: newTestBaseClient(server)}
+	workRequestId := "ocid1.loadbalancerworkrequest.oc1..aaaa"
+	d := ListenerResource().TestResourceData()
+
+	wr, err := LoadBalancerWaitForWorkRequestId(&client, d, &workRequestId, nil)
+	expected := "CreateListener work request ocid1.loadbalancerworkrequest.oc1..aaaa failed: BAD_INPUT: Backend set does not exist"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+	if wr == nil || wr.LifecycleState != oci_load_balancer.WorkRequestLifecycleStateFailed {
+		t.Errorf("expected the work request to be returned in its final state, got %v", wr)
+	}
+
+	wr.ErrorDetails = nil
+	expected = "CreateListener work request ocid1.loadbalancerworkrequest.oc1..aaaa failed: Listener creation failed"
+	if err := getLoadBalancerWorkRequestError(wr); err.Error() != expected {
+		t.Errorf("expected the work request message when there are no error details, got %q", err)
+	}
+}
+
+func TestLoadBalancerResourceCrudGet(t *testing.T) {
+	workRequestState := "IN_PROGRESS"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "/loadBalancerWorkRequests/") {
+			w.Write([]byte(fmt.Sprintf(`{"id": "ocid1.loadbalancerworkrequest.oc1..aaaa", "loadBalancerId": "ocid1.loadbalancer.oc1..aaaa",
+				"type": "CreateLoadBalancer", "lifecycleState": "%s", "message": "", "errorDetails": []}`, workRequestState)))
+			return
+		}
+		w.Write([]byte(`{"id": "ocid1.loadbalancer.oc1..aaaa", "lifecycleState": "ACTIVE"}`))
+	}))
+	defer server.Close()
+
+	client := oci_load_balancer.LoadBalancerClient{BaseClient: newTestBaseClient(server)}
+
+	// The id of a create work request that is still running is kept
+	sync := &LoadBalancerResourceCrud{Client: &client}
+	sync.D = LoadBalancerResource().TestResourceData()
+	sync.D.SetId("ocid1.loadbalancerworkrequest.oc1..aaaa")
+	if err := sync.Get(); err != nil {
+		t.Errorf("expected no error while the work request is running, got %q", err)
+	}
+	if sync.D.Id() != "ocid1.loadbalancerworkrequest.oc1..aaaa" || sync.Res != nil {
+		t.Errorf("expected the work request id to be kept, got %s", sync.D.Id())
+	}
+
+	// Once it finished, the id is replaced with the load balancer's
+	workRequestState = "SUCCEEDED"
+	if err := sync.Get(); err != nil {
+		t.Errorf("expected no error, got %q", err)
+	}
+	if sync.D.Id() != "ocid1.loadbalancer.oc1..aaaa" || sync.Res == nil {
+		t.Errorf("expected the load balancer to be read, got %s", sync.D.Id())
+	}
+
+	sync = &LoadBalancerResourceCrud{Client: &client}
+	sync.D = LoadBalancerResource().TestResourceData()
+	sync.D.SetId("my-load-balancer")
+	expected := `"my-load-balancer" is not the OCID of a load balancer`
+	if err := sync.Get(); err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+}
+
+func TestCompositeId(t *testing.T) {
+	compositeId := buildCompositeId("loadBalancers", "ocid1.loadbalancer.oc1..aaaa", "listeners", "http/80")
+	if compositeId != "loadBalancers/ocid1.loadbalancer.oc1..aaaa/listeners/http%2F80" {
+		t.Errorf("unexpected compositeId %s", compositeId)
+	}
+
+	ids, err := parseCompositeId(compositeId, "loadBalancers", "listeners")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if ids[0] != "ocid1.loadbalancer.oc1..aaaa" || ids[1] != "http/80" {
+		t.Errorf("unexpected ids %v", ids)
+	}
+
+	for _, illegal := range []string{
+		"ocid1.loadbalancer.oc1..aaaa",
+		"loadBalancers/ocid1.loadbalancer.oc1..aaaa/listeners",
+		"loadBalancers/ocid1.loadbalancer.oc1..aaaa/backendSets/http",
+		"loadBalancers//listeners/http",
+		"loadBalancers/ocid1.loadbalancer.oc1..aaaa/listeners/http/80",
+	} {
+		if _, err := parseCompositeId(illegal, "loadBalancers", "listeners"); err == nil {
+			t.Errorf("expected an error for compositeId %s", illegal)
+		}
+	}
+}
+
+type notFoundTestResourceCrud struct {
+	BaseCrud
+	notFoundAttempts int
+	getAttempts      int
+	notFoundError    error
+}
+
+func (s *notFoundTestResourceCrud) Get() error {
+	s.getAttempts++
+	if s.getAttempts <= s.notFoundAttempts {
+		return s.notFoundError
+	}
+	return nil
+}
+
+func TestGetWithCreatedGracePeriod(t *testing.T) {
+	defer func(interval time.Duration) { createdNotFoundRetryInterval = interval }(createdNotFoundRetryInterval)
+	createdNotFoundRetryInterval = 10 * time.Millisecond
+	notFoundError := getTestServiceError(t, 404, "NotAuthorizedOrNotFound")
+
+	d := VcnResource().TestResourceData()
+	d.SetId("ocid1.vcn.oc1..created")
+	createdResources.add(d.Id())
+	defer createdResources.remove(d.Id())
+
+	sync := &notFoundTestResourceCrud{BaseCrud: BaseCrud{D: d}, notFoundAttempts: 2, notFoundError: notFoundError}
+	if err := getWithCreatedGracePeriod(sync); err != nil || sync.getAttempts != 3 {
+		t.Errorf("expected not found errors to be retried for a new resource, got %v after %d attempts", err, sync.getAttempts)
+	}
+
+	// Resources that were not created recently are missing right away
+	other := VcnResource().TestResourceData()
+	other.SetId("ocid1.vcn.oc1..existing")
+	sync = &notFoundTestResourceCrud{BaseCrud: BaseCrud{D: other}, notFoundAttempts: 2, notFoundError: notFoundError}
+	if err := getWithCreatedGracePeriod(sync); err != notFoundError || sync.getAttempts != 1 {
+		t.Errorf("expected the not found error to be returned, got %v after %d attempts", err, sync.getAttempts)
+	}
+
+	// The not found error is returned once the grace period has passed
+	defer func(gracePeriod time.Duration) { createdNotFoundGracePeriod = gracePeriod }(createdNotFoundGracePeriod)
+	createdNotFoundGracePeriod = 50 * time.Millisecond
+	createdResources.add(d.Id())
+	sync = &notFoundTestResourceCrud{BaseCrud: BaseCrud{D: d}, notFoundAttempts: 1000, notFoundError: notFoundError}
+	if err := getWithCreatedGracePeriod(sync); err != notFoundError || sync.getAttempts < 2 {
+		t.Errorf("expected the not found error to be returned after the grace period, got %v after %d attempts", err, sync.getAttempts)
+	}
+}
+
+func TestResourceETags(t *testing.T) {
+	etagVersion := 1
+	var ifMatches []string
+	var gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			gets++
+		case http.MethodPut:
+			// The route table attachment updates the subnet without If-Match
+			if ifMatch := r.Header.Get("if-match"); r.URL.Path != "/subnets/ocid1.subnet.oc1..etag" || ifMatch != "" {
+				ifMatches = append(ifMatches, ifMatch)
+				if ifMatch != fmt.Sprintf("etag-%d", etagVersion) {
+					w.WriteHeader(http.StatusPreconditionFailed)
+					w.Write([]byte(`{"code": "NoEtagMatch", "message": "The resource has changed"}`))
+					return
+				}
+			}
+			etagVersion++
+		case http.MethodDelete:
+			ifMatches = append(ifMatches, r.Header.Get("if-match"))
+			if r.Header.Get("if-match") != fmt.Sprintf("etag-%d", etagVersion) {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				w.Write([]byte(`{"code": "NoEtagMatch", "message": "The resource has changed"}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("etag", fmt.Sprintf("etag-%d", etagVersion))
+		w.Write([]byte(`{"id": "ocid1.subnet.oc1..etag", "routeTableId": "ocid1.routetable.oc1..aaaa", "lifecycleState": "AVAILABLE"}`))
+	}))
+	defer server.Close()
+	client := &oci_core.VirtualNetworkClient{BaseClient: newTestBaseClient(server)}
+
+	// The ETag is kept in the state when the subnet is refreshed
+	d := SubnetResource().TestResourceData()
+	d.SetId("ocid1.subnet.oc1..etag")
+	sync := &SubnetResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+	if err := sync.Get(); err != nil || d.Get("etag").(string) != "etag-1" {
+		t.Fatalf("expected the etag of the read to be kept in the state, got %v and %q", err, d.Get("etag"))
+	}
+
+	// A change made outside Terraform since the refresh is reported, without reading the subnet again
+	etagVersion = 2
+	gets = 0
+	if err := sync.Update(); err == nil || !strings.Contains(handleServiceError(sync, "Update", err).Error(), "changed outside Terraform since it was last refreshed") {
+		t.Errorf("expected the update of a subnet changed since it was refreshed to fail, got %v", err)
+	}
+	if gets != 0 {
+		t.Errorf("expected the subnet not to be read before the update, got %d reads", gets)
+	}
+
+	// The ETag returned by an update is kept for the next one
+	if err := sync.Get(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := sync.Update(); err != nil || d.Get("etag").(string) != "etag-3" {
+		t.Errorf("expected the refreshed subnet to be updated, got %v and the etag %q", err, d.Get("etag"))
+	}
+
+	// The subnet is updated by an oci_core_route_table_attachment of the same configuration after it was refreshed
+	attachmentData := RouteTableAttachmentResource().TestResourceData()
+	attachmentData.Set("subnet_id", "ocid1.subnet.oc1..etag")
+	attachmentData.Set("route_table_id", "ocid1.routetable.oc1..aaaa")
+	attachment := &RouteTableAttachmentResourceCrud{BaseCrud: BaseCrud{D: attachmentData}, Client: client, DisableNotFoundRetries: true}
+	if err := attachment.Create(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := sync.Delete(); err != nil {
+		t.Errorf("expected the delete to use the etag of the update made by the attachment, got %v", err)
+	}
+	if fmt.Sprint(ifMatches) != "[etag-1 etag-2 etag-4]" {
+		t.Errorf("unexpected if-match headers %v", ifMatches)
+	}
+
+	// Resources that were never refreshed are updated unconditionally
+	d = SubnetResource().TestResourceData()
+	d.SetId("ocid1.subnet.oc1..bbbb")
+	if ifMatch := (&BaseCrud{D: d}).getIfMatch(); ifMatch != nil {
+		t.Errorf("expected no etag, got %s", *ifMatch)
+	}
+}
+
+func TestHandleMissingResourceError(t *testing.T) {
+	for _, missing := range []error{
+		getTestServiceError(t, 404, "NotFound"),
+		getTestServiceError(t, 404, "NotAuthorizedOrNotFound"),
+		fmt.Errorf("route table attachment ocid1.subnet.oc1..aaaa/ocid1.routetable.oc1..aaaa does not exist"),
+		fmt.Errorf("Load balancer ocid1.loadbalancer.oc1..aaaa has no listener named http"),
+	} {
+		d := VcnResource().TestResourceData()
+		d.SetId("ocid1.vcn.oc1..aaaa")
+		err := missing
+		handleMissingResourceError(&VcnResourceCrud{BaseCrud: BaseCrud{D: d}}, &err)
+		if err != nil || d.Id() != "" {
+			t.Errorf("expected %q to remove the resource from the state, got error %v and id %q", missing, err, d.Id())
+		}
+	}
+
+	d := VcnResource().TestResourceData()
+	d.SetId("ocid1.vcn.oc1..aaaa")
+	internalError := getTestServiceError(t, 500, "InternalServerError")
+	err := internalError
+	handleMissingResourceError(&VcnResourceCrud{BaseCrud: BaseCrud{D: d}}, &err)
+	if err != internalError || d.Id() == "" {
+		t.Errorf("expected other errors to be returned unchanged, got error %v and id %q", err, d.Id())
+	}
+
+	err = nil
+	handleMissingResourceError(&VcnResourceCrud{BaseCrud: BaseCrud{D: d}}, &err)
+	if err != nil || d.Id() == "" {
+		t.Errorf("expected no error to leave the resource in the state")
+	}
+}
+
+func TestHandleMissingResourceErrorNotAuthorizedOrNotFound(t *testing.T) {
+	listedVcns := `[{"id": "ocid1.vcn.oc1..aaaa", "compartmentId": "ocid1.compartment.oc1..aaaa", "lifecycleState": "AVAILABLE"}]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(listedVcns))
+	}))
+	defer server.Close()
+	client := &oci_core.VirtualNetworkClient{BaseClient: newTestBaseClient(server)}
+	notAuthorizedOrNotFound := getTestServiceError(t, 404, "NotAuthorizedOrNotFound")
+
+	// The VCN is still listed, so the user is not authorized to access it
+	d := VcnResource().TestResourceData()
+	d.SetId("ocid1.vcn.oc1..aaaa")
+	d.Set("compartment_id", "ocid1.compartment.oc1..aaaa")
+	err := notAuthorizedOrNotFound
+	handleMissingResourceError(&VcnResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client}, &err)
+	if err != notAuthorizedOrNotFound || d.Id() == "" {
+		t.Errorf("expected a VCN that is still listed to be kept in the state, got error %v and id %q", err, d.Id())
+	}
+
+	// The VCN is not listed anymore, so it was deleted
+	listedVcns = `[]`
+	handleMissingResourceError(&VcnResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client}, &err)
+	if err != nil || d.Id() != "" {
+		t.Errorf("expected a VCN that is not listed to be removed from the state, got error %v and id %q", err, d.Id())
+	}
+
+	// Resources that can not confirm that they were deleted are removed from the state
+	d = RouteTableResource().TestResourceData()
+	d.SetId("ocid1.routetable.oc1..aaaa")
+	err = notAuthorizedOrNotFound
+	handleMissingResourceError(&RouteTableResourceCrud{BaseCrud: BaseCrud{D: d}}, &err)
+	if err != nil || d.Id() != "" {
+		t.Errorf("expected the resource to be removed from the state, got error %v and id %q", err, d.Id())
+	}
+	if message := handleServiceError(&RouteTableResourceCrud{}, "Update", notAuthorizedOrNotFound).Error(); !strings.Contains(message, "terraform state rm") {
+		t.Errorf("expected the error to explain how to remove a deleted resource from the state, got %q", message)
+	}
+}
+
+type failedCreateTestResourceCrud struct {
+	BaseCrud
+	failures  int
+	creates   int
+	cleanUps  int
+	retryable bool
+}
+
+func (s *failedCreateTestResourceCrud) ID() string {
+	return "ocid1.loadbalancer.oc1..aaaa"
+}
+
+func (s *failedCreateTestResourceCrud) Create() error {
+	s.creates++
+	if s.creates <= s.failures {
+		return fmt.Errorf("CreateLoadBalancer work request failed: INTERNAL_ERROR: internal error")
+	}
+	return nil
+}
+
+func (s *failedCreateTestResourceCrud) SetData() error {
+	return nil
+}
+
+func (s *failedCreateTestResourceCrud) IsCreateFailureRetryable() bool {
+	return s.retryable
+}
+
+func (s *failedCreateTestResourceCrud) CleanUpFailedCreate() error {
+	s.cleanUps++
+	return nil
+}
+
+func TestRetryFailedCreate(t *testing.T) {
+	defer func(retries uint) { configuredCreateFailureRetries = retries }(configuredCreateFailureRetries)
+	configuredCreateFailureRetries = 2
+
+	sync := &failedCreateTestResourceCrud{BaseCrud: BaseCrud{D: LoadBalancerResource().TestResourceData()}, failures: 2, retryable: true}
+	if err := CreateResource(sync.D, sync); err != nil || sync.creates != 3 || sync.cleanUps != 2 {
+		t.Errorf("expected the creation to succeed on the third attempt, got %v after %d attempts and %d clean ups", err, sync.creates, sync.cleanUps)
+	}
+
+	sync = &failedCreateTestResourceCrud{BaseCrud: BaseCrud{D: LoadBalancerResource().TestResourceData()}, failures: 3, retryable: true}
+	if err := CreateResource(sync.D, sync); err == nil || sync.creates != 3 {
+		t.Errorf("expected the creation to fail after the configured retries, got %v after %d attempts", err, sync.creates)
+	}
+
+	sync = &failedCreateTestResourceCrud{BaseCrud: BaseCrud{D: LoadBalancerResource().TestResourceData()}, failures: 1, retryable: false}
+	if err := CreateResource(sync.D, sync); err == nil || sync.creates != 1 || sync.cleanUps != 0 {
+		t.Errorf("expected failures that are not transient to not be retried, got %v after %d attempts", err, sync.creates)
+	}
+
+	if !isTransientFailureMessage("Out of host capacity.") || !isTransientFailureMessage("Internal error, please retry") || isTransientFailureMessage("Invalid subnet") {
+		t.Errorf("unexpected classification of work request failure messages")
+	}
+}
+
+type statefulTestResourceCrud struct {
+	BaseCrud
+	Res      *statefulTestResource
+	states   []string
+	getError error
+	getCalls int
+}
+
+type statefulTestResource struct {
+	LifecycleState string
+}
+
+func (s *statefulTestResourceCrud) Get() error {
+	s.getCalls++
+	if s.getCalls > len(s.states) {
+		return s.getError
+	}
+	s.Res = &statefulTestResource{LifecycleState: s.states[s.getCalls-1]}
+	return nil
+}
+
+func (s *statefulTestResourceCrud) SetData() error {
+	return nil
+}
+
+func (s *statefulTestResourceCrud) DeletedPending() []string {
+	return []string{"TERMINATING"}
+}
+
+func (s *statefulTestResourceCrud) DeletedTarget() []string {
+	return []string{"TERMINATED"}
+}
+
+func TestWaitForDeletedState(t *testing.T) {
+	d := VcnResource().TestResourceData()
+	d.SetId("ocid1.vcn.oc1..aaaa")
+	sync := &statefulTestResourceCrud{BaseCrud: BaseCrud{D: d}, states: []string{"TERMINATING", "TERMINATED"}}
+	if err := waitForDeletedState(sync, time.Minute); err != nil || sync.getCalls != 2 {
+		t.Errorf("expected the wait to end in the deleted state, got %v after %d calls", err, sync.getCalls)
+	}
+
+	// A resource that disappears while it is being deleted is removed from the state
+	sync = &statefulTestResourceCrud{BaseCrud: BaseCrud{D: d}, states: []string{"TERMINATING"}, getError: getTestServiceError(t, 404, "NotAuthorizedOrNotFound")}
+	if err := waitForDeletedState(sync, time.Minute); err != nil || d.Id() != "" {
+		t.Errorf("expected a missing resource to be deleted, got %v and id %q", err, d.Id())
+	}
+
+	// Resources that are not deleted asynchronously are not waited for
+	if err := waitForDeletedState(&failedCreateTestResourceCrud{}, time.Minute); err != nil {
+		t.Errorf("expected no wait for a resource without deleted states, got %v", err)
+	}
+}
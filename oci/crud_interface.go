@@ -72,6 +72,24 @@ type ExtraWaitPostCreateDelete interface {
 	ExtraWaitPostCreateDelete() time.Duration
 }
 
+// Some resources are created by a work request that may fail for a transient reason, such as an internal error or a
+// temporary lack of capacity. CreateResource requests their creation again, up to the number of times configured in
+// the provider, after deleting what the failed attempt left behind.
+type RetryableCreateFailure interface {
+	// IsCreateFailureRetryable returns true when the last creation failed for a reason that may not happen again
+	IsCreateFailureRetryable() bool
+	// CleanUpFailedCreate deletes the resource left behind by the failed creation, if any, and clears its id
+	CleanUpFailedCreate() error
+}
+
+// Services return 404 NotAuthorizedOrNotFound both for a resource that was deleted and for a resource the user is not
+// authorized to access. Resources are removed from the state on either, except the resources that can tell the two apart,
+// e.g. by listing the resources of their parent, and find that they still exist.
+type MissingResourceConfirmer interface {
+	// ConfirmMissing returns true when the resource was deleted
+	ConfirmMissing() (bool, error)
+}
+
 type StatefulResource interface {
 	ResourceReader
 	State() string
@@ -135,7 +135,7 @@ func (s *AutonomousDataWarehouseBackupResourceCrud) Create() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.CreateAutonomousDataWarehouseBackup(context.Background(), request)
 	if err != nil {
@@ -152,7 +152,7 @@ func (s *AutonomousDataWarehouseBackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutonomousDataWarehouseBackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.GetAutonomousDataWarehouseBackup(context.Background(), request)
 	if err != nil {
@@ -262,7 +262,7 @@ func (s *AutonomousDataWarehouseResourceCrud) Create() error {
 		request.LicenseModel = oci_database.CreateAutonomousDataWarehouseDetailsLicenseModelEnum(licenseModel.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.CreateAutonomousDataWarehouse(context.Background(), request)
 	if err != nil {
@@ -279,7 +279,7 @@ func (s *AutonomousDataWarehouseResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutonomousDataWarehouseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.GetAutonomousDataWarehouse(context.Background(), request)
 	if err != nil {
@@ -327,7 +327,7 @@ func (s *AutonomousDataWarehouseResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousDataWarehouse(context.Background(), request)
 	if err != nil {
@@ -344,7 +344,7 @@ func (s *AutonomousDataWarehouseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousDataWarehouseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteAutonomousDataWarehouse(context.Background(), request)
 	return err
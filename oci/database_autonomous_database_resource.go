@@ -262,7 +262,7 @@ func (s *AutonomousDatabaseResourceCrud) Create() error {
 		request.LicenseModel = oci_database.CreateAutonomousDatabaseDetailsLicenseModelEnum(licenseModel.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.CreateAutonomousDatabase(context.Background(), request)
 	if err != nil {
@@ -279,7 +279,7 @@ func (s *AutonomousDatabaseResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
 	if err != nil {
@@ -329,7 +329,7 @@ func (s *AutonomousDatabaseResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), request)
 	if err != nil {
@@ -346,7 +346,7 @@ func (s *AutonomousDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteAutonomousDatabase(context.Background(), request)
 	return err
@@ -154,7 +154,7 @@ func (s *BackupResourceCrud) Create() error {
 		request.DisplayName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.CreateBackup(context.Background(), request)
 	if err != nil {
@@ -171,7 +171,7 @@ func (s *BackupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.GetBackup(context.Background(), request)
 	if err != nil {
@@ -188,7 +188,7 @@ func (s *BackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteBackup(context.Background(), request)
 	return err
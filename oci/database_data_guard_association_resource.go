@@ -0,0 +1,371 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+func DataGuardAssociationResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: &TwoHours,
+		},
+		Create: createDataGuardAssociation,
+		Read:   readDataGuardAssociation,
+		Delete: deleteDataGuardAssociation,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"creation_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ExistingDbSystem",
+					"NewDbSystem",
+				}, true),
+			},
+			"database_admin_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protection_mode": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.CreateDataGuardAssociationDetailsProtectionModeAvailability),
+					string(oci_database.CreateDataGuardAssociationDetailsProtectionModePerformance),
+					string(oci_database.CreateDataGuardAssociationDetailsProtectionModeProtection),
+				}, true),
+			},
+			"transport_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.CreateDataGuardAssociationDetailsTransportTypeAsync),
+					string(oci_database.CreateDataGuardAssociationDetailsTransportTypeFastsync),
+					string(oci_database.CreateDataGuardAssociationDetailsTransportTypeSync),
+				}, true),
+			},
+
+			// Optional
+			"availability_domain": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"peer_db_system_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("subnet"),
+			},
+
+			// Computed
+			"apply_lag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"apply_rate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_data_guard_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_database_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_db_home_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &DataGuardAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return CreateResource(d, sync)
+}
+
+func readDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &DataGuardAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return ReadResource(sync)
+}
+
+// There is no API to remove a Data Guard association. The standby is removed by terminating its DB system.
+func deleteDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type DataGuardAssociationResourceCrud struct {
+	BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.DataGuardAssociation
+	DisableNotFoundRetries bool
+}
+
+func (s *DataGuardAssociationResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DataGuardAssociationResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_database.DataGuardAssociationLifecycleStateProvisioning),
+	}
+}
+
+func (s *DataGuardAssociationResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_database.DataGuardAssociationLifecycleStateAvailable),
+	}
+}
+
+func (s *DataGuardAssociationResourceCrud) Create() error {
+	request := oci_database.CreateDataGuardAssociationRequest{}
+
+	createDataGuardAssociationDetails, err := s.mapToCreateDataGuardAssociationDetails()
+	if err != nil {
+		return err
+	}
+	request.CreateDataGuardAssociationDetails = createDataGuardAssociationDetails
+
+	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
+		tmp := databaseId.(string)
+		request.DatabaseId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.CreateDataGuardAssociation(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DataGuardAssociation
+	return nil
+}
+
+func (s *DataGuardAssociationResourceCrud) Get() error {
+	request := oci_database.GetDataGuardAssociationRequest{}
+
+	tmp := s.D.Id()
+	request.DataGuardAssociationId = &tmp
+
+	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
+		tmp := databaseId.(string)
+		request.DatabaseId = &tmp
+	}
+
+	databaseId, dataGuardAssociationId, parseDataGuardAssociationCompositeIdErr := parseDataGuardAssociationCompositeId(s.D.Id())
+	if parseDataGuardAssociationCompositeIdErr == nil {
+		request.DatabaseId = &databaseId
+		request.DataGuardAssociationId = &dataGuardAssociationId
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DataGuardAssociation
+	if parseDataGuardAssociationCompositeIdErr == nil {
+		// Import sets the ID to composite ID and hence overwriting ID to OCID from response
+		s.D.SetId(dataGuardAssociationId)
+	}
+	return nil
+}
+
+func (s *DataGuardAssociationResourceCrud) SetData() error {
+	if s.Res.ApplyLag != nil {
+		s.D.Set("apply_lag", *s.Res.ApplyLag)
+	}
+
+	if s.Res.ApplyRate != nil {
+		s.D.Set("apply_rate", *s.Res.ApplyRate)
+	}
+
+	if s.Res.DatabaseId != nil {
+		s.D.Set("database_id", *s.Res.DatabaseId)
+	}
+
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	if s.Res.PeerDataGuardAssociationId != nil {
+		s.D.Set("peer_data_guard_association_id", *s.Res.PeerDataGuardAssociationId)
+	}
+
+	if s.Res.PeerDatabaseId != nil {
+		s.D.Set("peer_database_id", *s.Res.PeerDatabaseId)
+	}
+
+	if s.Res.PeerDbHomeId != nil {
+		s.D.Set("peer_db_home_id", *s.Res.PeerDbHomeId)
+	}
+
+	if s.Res.PeerDbSystemId != nil {
+		s.D.Set("peer_db_system_id", *s.Res.PeerDbSystemId)
+	}
+
+	s.D.Set("peer_role", s.Res.PeerRole)
+
+	s.D.Set("protection_mode", s.Res.ProtectionMode)
+
+	s.D.Set("role", s.Res.Role)
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	s.D.Set("transport_type", s.Res.TransportType)
+
+	return nil
+}
+
+func parseDataGuardAssociationCompositeId(compositeId string) (databaseId string, dataGuardAssociationId string, err error) {
+	ids, err := parseCompositeId(compositeId, "databases", "dataGuardAssociations")
+	if err != nil {
+		return
+	}
+	databaseId, dataGuardAssociationId = ids[0], ids[1]
+
+	return
+}
+
+func (s *DataGuardAssociationResourceCrud) mapToCreateDataGuardAssociationDetails() (oci_database.CreateDataGuardAssociationDetails, error) {
+	var baseObject oci_database.CreateDataGuardAssociationDetails
+	//discriminator
+	creationTypeRaw, ok := s.D.GetOkExists("creation_type")
+	var creationType string
+	if ok {
+		creationType = creationTypeRaw.(string)
+	} else {
+		creationType = "" // default value
+	}
+
+	var databaseAdminPassword *string
+	if password, ok := s.D.GetOkExists("database_admin_password"); ok {
+		tmp := password.(string)
+		databaseAdminPassword = &tmp
+	}
+	var protectionMode oci_database.CreateDataGuardAssociationDetailsProtectionModeEnum
+	if mode, ok := s.D.GetOkExists("protection_mode"); ok {
+		protectionMode = oci_database.CreateDataGuardAssociationDetailsProtectionModeEnum(mode.(string))
+	}
+	var transportType oci_database.CreateDataGuardAssociationDetailsTransportTypeEnum
+	if transport, ok := s.D.GetOkExists("transport_type"); ok {
+		transportType = oci_database.CreateDataGuardAssociationDetailsTransportTypeEnum(transport.(string))
+	}
+
+	switch strings.ToLower(creationType) {
+	case strings.ToLower("ExistingDbSystem"):
+		details := oci_database.CreateDataGuardAssociationToExistingDbSystemDetails{}
+		details.DatabaseAdminPassword = databaseAdminPassword
+		details.ProtectionMode = protectionMode
+		details.TransportType = transportType
+		if peerDbSystemId, ok := s.D.GetOkExists("peer_db_system_id"); ok {
+			tmp := peerDbSystemId.(string)
+			details.PeerDbSystemId = &tmp
+		}
+		baseObject = details
+	case strings.ToLower("NewDbSystem"):
+		details := oci_database.CreateDataGuardAssociationWithNewDbSystemDetails{}
+		details.DatabaseAdminPassword = databaseAdminPassword
+		details.ProtectionMode = protectionMode
+		details.TransportType = transportType
+		if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
+			tmp := availabilityDomain.(string)
+			details.AvailabilityDomain = &tmp
+		}
+		if displayName, ok := s.D.GetOkExists("display_name"); ok {
+			tmp := displayName.(string)
+			details.DisplayName = &tmp
+		}
+		if hostname, ok := s.D.GetOkExists("hostname"); ok {
+			tmp := hostname.(string)
+			details.Hostname = &tmp
+		}
+		if subnetId, ok := s.D.GetOkExists("subnet_id"); ok {
+			tmp := subnetId.(string)
+			details.SubnetId = &tmp
+		}
+		baseObject = details
+	default:
+		return nil, fmt.Errorf("unknown creation_type '%v' was specified", creationType)
+	}
+	return baseObject, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+var (
+	dataGuardAssociationRepresentation = map[string]interface{}{
+		"creation_type":           Representation{repType: Required, create: `NewDbSystem`},
+		"database_admin_password": Representation{repType: Required, create: `BEstrO0ng_#11`},
+		"database_id":             Representation{repType: Required, create: `${data.oci_database_databases.db.databases.0.id}`},
+		"protection_mode":         Representation{repType: Required, create: `MAXIMUM_PERFORMANCE`},
+		"transport_type":          Representation{repType: Required, create: `ASYNC`},
+		"availability_domain":     Representation{repType: Required, create: `${oci_core_subnet.test_subnet.availability_domain}`},
+		"display_name":            Representation{repType: Optional, create: `standbyDbSystem`},
+		"hostname":                Representation{repType: Required, create: `standbyDB`},
+		"subnet_id":               Representation{repType: Required, create: `${oci_core_subnet.test_subnet.id}`},
+	}
+
+	DataGuardAssociationResourceDependencies = DbSystemResourceConfig + `
+data "oci_database_databases" "db" {
+       compartment_id = "${var.compartment_id}"
+       db_home_id = "${data.oci_database_db_homes.t.db_homes.0.db_home_id}"
+}`
+)
+
+func TestDatabaseDataGuardAssociationResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_data_guard_association.test_data_guard_association"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + DataGuardAssociationResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_data_guard_association", "test_data_guard_association", Optional, Create, dataGuardAssociationRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "database_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_database_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_db_system_id"),
+					resource.TestCheckResourceAttr(resourceName, "protection_mode", "MAXIMUM_PERFORMANCE"),
+					resource.TestCheckResourceAttr(resourceName, "role", "PRIMARY"),
+					resource.TestCheckResourceAttr(resourceName, "peer_role", "STANDBY"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "transport_type", "ASYNC"),
+				),
+			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getDataGuardAssociationCompositeId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"availability_domain",
+					"creation_type",
+					"database_admin_password",
+					"display_name",
+					"hostname",
+					"subnet_id",
+				},
+				ResourceName: resourceName,
+			},
+		},
+	})
+}
+
+func getDataGuardAssociationCompositeId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("databases/%s/dataGuardAssociations/%s", rs.Primary.Attributes["database_id"], rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestDataGuardAssociationResourceImport(t *testing.T) {
+	var requestedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ocid1.dgassociation.oc1..bbbb", "databaseId": "ocid1.database.oc1..aaaa", "role": "PRIMARY", "lifecycleState": "AVAILABLE"}`))
+	}))
+	defer server.Close()
+	client := &oci_database.DatabaseClient{BaseClient: newTestBaseClient(server)}
+
+	d := DataGuardAssociationResource().TestResourceData()
+	d.SetId("databases/ocid1.database.oc1..aaaa/dataGuardAssociations/ocid1.dgassociation.oc1..bbbb")
+	sync := &DataGuardAssociationResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+
+	if err := ReadResource(sync); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requestedPath != "/databases/ocid1.database.oc1..aaaa/dataGuardAssociations/ocid1.dgassociation.oc1..bbbb" {
+		t.Errorf("expected the association to be read from its database, got %s", requestedPath)
+	}
+	if d.Id() != "ocid1.dgassociation.oc1..bbbb" {
+		t.Errorf("expected the id to be the OCID of the association, got %s", d.Id())
+	}
+	if databaseId := d.Get("database_id").(string); databaseId != "ocid1.database.oc1..aaaa" {
+		t.Errorf("expected the database id to be set, got %s", databaseId)
+	}
+
+	if _, _, err := parseDataGuardAssociationCompositeId("ocid1.dgassociation.oc1..bbbb"); err == nil {
+		t.Errorf("expected an OCID not to be parsed as a composite id")
+	}
+}
@@ -245,7 +245,7 @@ func (s *DbHomeResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.CreateDbHome(context.Background(), request)
 	if err != nil {
@@ -262,7 +262,7 @@ func (s *DbHomeResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DbHomeId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.GetDbHome(context.Background(), request)
 	if err != nil {
@@ -284,7 +284,7 @@ func (s *DbHomeResourceCrud) Delete() error {
 		request.PerformFinalBackup = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteDbHome(context.Background(), request)
 	return err
@@ -0,0 +1,245 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+func DbNodePowerManagementResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createDbNodePowerManagement,
+		Read:     readDbNodePowerManagement,
+		Update:   updateDbNodePowerManagement,
+		Delete:   deleteDbNodePowerManagement,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"db_node_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"reset_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.DbNodeLifecycleStateAvailable),
+					string(oci_database.DbNodeLifecycleStateStopped),
+				}, true),
+			},
+
+			// Computed
+			"backup_vnic_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_system_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnic_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDbNodePowerManagement(d *schema.ResourceData, m interface{}) error {
+	sync := &DbNodePowerManagementResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return CreateResource(d, sync)
+}
+
+func readDbNodePowerManagement(d *schema.ResourceData, m interface{}) error {
+	sync := &DbNodePowerManagementResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return ReadResource(sync)
+}
+
+func updateDbNodePowerManagement(d *schema.ResourceData, m interface{}) error {
+	sync := &DbNodePowerManagementResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return UpdateResource(d, sync)
+}
+
+// The node belongs to its DB system, so it is left in whatever power state it is currently in
+func deleteDbNodePowerManagement(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type DbNodePowerManagementResourceCrud struct {
+	BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.DbNode
+	DisableNotFoundRetries bool
+}
+
+func (s *DbNodePowerManagementResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DbNodePowerManagementResourceCrud) Create() error {
+	request := oci_database.GetDbNodeRequest{}
+
+	if dbNodeId, ok := s.D.GetOkExists("db_node_id"); ok {
+		tmp := dbNodeId.(string)
+		request.DbNodeId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDbNode(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DbNode
+
+	if desiredState, ok := s.D.GetOkExists("state"); ok && !strings.EqualFold(desiredState.(string), string(s.Res.LifecycleState)) {
+		// ID is required for state refresh
+		s.D.SetId(s.ID())
+		return s.setDbNodeDesiredState(desiredState.(string), s.D.Timeout(schema.TimeoutCreate))
+	}
+
+	return nil
+}
+
+func (s *DbNodePowerManagementResourceCrud) Get() error {
+	request := oci_database.GetDbNodeRequest{}
+
+	tmp := s.D.Id()
+	request.DbNodeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDbNode(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DbNode
+	return nil
+}
+
+func (s *DbNodePowerManagementResourceCrud) Update() error {
+	// Starting or stopping the node already restarts it, so a reset_trigger that changes along with the state is only
+	// recorded
+	if desiredState, ok := s.D.GetOkExists("state"); ok && s.D.HasChange("state") {
+		return s.setDbNodeDesiredState(desiredState.(string), s.D.Timeout(schema.TimeoutUpdate))
+	}
+
+	if s.D.HasChange("reset_trigger") {
+		return s.resetDbNode(s.D.Timeout(schema.TimeoutUpdate))
+	}
+
+	return s.Get()
+}
+
+func (s *DbNodePowerManagementResourceCrud) SetData() error {
+	if s.Res.BackupVnicId != nil {
+		s.D.Set("backup_vnic_id", *s.Res.BackupVnicId)
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("db_node_id", *s.Res.Id)
+	}
+
+	if s.Res.DbSystemId != nil {
+		s.D.Set("db_system_id", *s.Res.DbSystemId)
+	}
+
+	if s.Res.Hostname != nil {
+		s.D.Set("hostname", *s.Res.Hostname)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.VnicId != nil {
+		s.D.Set("vnic_id", *s.Res.VnicId)
+	}
+
+	return nil
+}
+
+func (s *DbNodePowerManagementResourceCrud) setDbNodeDesiredState(desiredState string, timeout time.Duration) error {
+	switch oci_database.DbNodeLifecycleStateEnum(strings.ToUpper(desiredState)) {
+	case oci_database.DbNodeLifecycleStateAvailable:
+		return s.dbNodeAction(oci_database.DbNodeActionActionStart, timeout,
+			[]string{
+				string(oci_database.DbNodeLifecycleStateStopped),
+				string(oci_database.DbNodeLifecycleStateStarting),
+			},
+			[]string{string(oci_database.DbNodeLifecycleStateAvailable)})
+	case oci_database.DbNodeLifecycleStateStopped:
+		return s.dbNodeAction(oci_database.DbNodeActionActionStop, timeout,
+			[]string{
+				string(oci_database.DbNodeLifecycleStateAvailable),
+				string(oci_database.DbNodeLifecycleStateStopping),
+			},
+			[]string{string(oci_database.DbNodeLifecycleStateStopped)})
+	default:
+		return fmt.Errorf("received unknown 'state' %s", desiredState)
+	}
+}
+
+// resetDbNode powers the node off and back on, like a hard reset of a physical server
+func (s *DbNodePowerManagementResourceCrud) resetDbNode(timeout time.Duration) error {
+	return s.dbNodeAction(oci_database.DbNodeActionActionReset, timeout,
+		[]string{
+			string(oci_database.DbNodeLifecycleStateStopping),
+			string(oci_database.DbNodeLifecycleStateStopped),
+			string(oci_database.DbNodeLifecycleStateStarting),
+		},
+		[]string{string(oci_database.DbNodeLifecycleStateAvailable)})
+}
+
+func (s *DbNodePowerManagementResourceCrud) dbNodeAction(action oci_database.DbNodeActionActionEnum, timeout time.Duration, pending []string, target []string) error {
+	request := oci_database.DbNodeActionRequest{}
+
+	tmp := s.D.Id()
+	request.DbNodeId = &tmp
+
+	request.Action = action
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.DbNodeAction(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DbNode
+
+	return waitForStateRefresh(s, timeout, "update", pending, target)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+var (
+	dbNodePowerManagementRepresentation = map[string]interface{}{
+		"db_node_id":    Representation{repType: Required, create: `${lookup(data.oci_database_db_nodes.test_db_nodes.db_nodes[0], "id")}`},
+		"reset_trigger": Representation{repType: Optional, create: `1`, update: `2`},
+		"state":         Representation{repType: Optional, create: `STOPPED`, update: `AVAILABLE`},
+	}
+
+	DbNodePowerManagementResourceDependencies = DbSystemResourceConfig + `
+data "oci_database_db_nodes" "test_db_nodes" {
+	compartment_id = "${var.compartment_id}"
+	db_system_id = "${oci_database_db_system.test_db_system.id}"
+}`
+)
+
+func TestDatabaseDbNodePowerManagementResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_db_node_power_management.test_db_node_power_management"
+
+	var resId, resId2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + DbNodePowerManagementResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_db_node_power_management", "test_db_node_power_management", Required, Create, dbNodePowerManagementRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "db_node_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "db_system_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "hostname"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "vnic_id"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+			// verify stop
+			{
+				Config: config + compartmentIdVariableStr + DbNodePowerManagementResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_db_node_power_management", "test_db_node_power_management", Optional, Create, dbNodePowerManagementRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reset_trigger", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify start, the reset_trigger changes along with the state so the node is not reset again
+			{
+				Config: config + compartmentIdVariableStr + DbNodePowerManagementResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_db_node_power_management", "test_db_node_power_management", Optional, Update, dbNodePowerManagementRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reset_trigger", "2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+			// verify reset
+			{
+				Config: config + compartmentIdVariableStr + DbNodePowerManagementResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_db_node_power_management", "test_db_node_power_management", Optional, Update,
+						getUpdatedRepresentationCopy("reset_trigger", Representation{repType: Optional, create: `3`}, dbNodePowerManagementRepresentation)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reset_trigger", "3"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reset_trigger"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func TestDbNodePowerManagementResourceUpdate(t *testing.T) {
+	for _, testCase := range []struct {
+		config          map[string]interface{}
+		expectedActions []string
+	}{
+		{map[string]interface{}{"db_node_id": "ocid1.dbnode.oc1..aaaa", "reset_trigger": "2"}, []string{"RESET"}},
+		{map[string]interface{}{"db_node_id": "ocid1.dbnode.oc1..aaaa", "reset_trigger": "2", "state": "STOPPED"}, []string{"STOP"}},
+	} {
+		nodeState := "AVAILABLE"
+		var actions []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if action := r.URL.Query().Get("action"); action != "" {
+				actions = append(actions, action)
+				if action == "STOP" {
+					nodeState = "STOPPED"
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"id": "ocid1.dbnode.oc1..aaaa", "dbSystemId": "ocid1.dbsystem.oc1..aaaa", "lifecycleState": %q}`, nodeState)))
+		}))
+		client := &oci_database.DatabaseClient{BaseClient: newTestBaseClient(server)}
+
+		d := schema.TestResourceDataRaw(t, DbNodePowerManagementResource().Schema, testCase.config)
+		d.SetId("ocid1.dbnode.oc1..aaaa")
+		sync := &DbNodePowerManagementResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+
+		err := sync.Update()
+		server.Close()
+
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", testCase.config, err)
+		}
+		if fmt.Sprint(actions) != fmt.Sprint(testCase.expectedActions) {
+			t.Errorf("expected the actions %v for %v, got %v", testCase.expectedActions, testCase.config, actions)
+		}
+	}
+}
@@ -5,8 +5,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -189,9 +191,10 @@ func DbSystemResource() *schema.Resource {
 				},
 			},
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("subnet"),
 			},
 
 			// Optional
@@ -264,6 +267,10 @@ func DbSystemResource() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"patch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"source": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -338,7 +345,19 @@ func createDbSystem(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateDBSystemResource(d, sync)
+	if err := CreateDBSystemResource(d, sync); err != nil {
+		return err
+	}
+
+	// A patch can only be applied to an existing DB system. A failure leaves patch_id empty in the state
+	// instead of failing the create, so that the next apply tries the patch again as an update.
+	if patchId, ok := d.GetOkExists("patch_id"); ok && patchId.(string) != "" {
+		if err := sync.applyPatch(); err != nil {
+			log.Printf("[WARN] Could not apply patch %s to the new DB system %s, it is retried on the next apply: %v", patchId, d.Id(), err)
+		}
+		return sync.SetData()
+	}
+	return nil
 }
 
 func readDbSystem(d *schema.ResourceData, m interface{}) error {
@@ -420,7 +439,7 @@ func (s *DbSystemResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.LaunchDbSystem(context.Background(), request)
 	if err != nil {
@@ -431,13 +450,36 @@ func (s *DbSystemResourceCrud) Create() error {
 	return nil
 }
 
+// IsCreateFailureRetryable returns true when the DB system failed to be provisioned because of an internal error or
+// for lack of capacity
+func (s *DbSystemResourceCrud) IsCreateFailureRetryable() bool {
+	return s.Res != nil && s.Res.LifecycleState == oci_database.DbSystemLifecycleStateFailed &&
+		s.Res.LifecycleDetails != nil && isTransientFailureMessage(*s.Res.LifecycleDetails)
+}
+
+// CleanUpFailedCreate terminates the DB system that failed to be provisioned
+func (s *DbSystemResourceCrud) CleanUpFailedCreate() error {
+	if s.D.Id() != "" {
+		if err := s.Delete(); err != nil && !isNotFoundError(err) {
+			return err
+		}
+		if err := waitForDeletedState(s, s.D.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	s.Res = nil
+	s.D.SetId("")
+	return nil
+}
+
 func (s *DbSystemResourceCrud) Get() error {
 	request := oci_database.GetDbSystemRequest{}
 
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.GetDbSystem(context.Background(), request)
 	if err != nil {
@@ -449,6 +491,12 @@ func (s *DbSystemResourceCrud) Get() error {
 }
 
 func (s *DbSystemResourceCrud) Update() error {
+	if patchId, ok := s.D.GetOkExists("patch_id"); ok && patchId.(string) != "" && s.D.HasChange("patch_id") {
+		if err := s.applyPatch(); err != nil {
+			return err
+		}
+	}
+
 	request := oci_database.UpdateDbSystemRequest{}
 
 	if cpuCoreCount, ok := s.D.GetOkExists("cpu_core_count"); ok {
@@ -488,7 +536,7 @@ func (s *DbSystemResourceCrud) Update() error {
 		request.SshPublicKeys = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateDbSystem(context.Background(), request)
 	if err != nil {
@@ -499,13 +547,129 @@ func (s *DbSystemResourceCrud) Update() error {
 	return nil
 }
 
+// applyPatch prechecks and then applies the patch of patch_id, so that a patch that cannot be applied fails before the
+// DB system is modified. When either step fails, the previous patch_id is kept in the state so that the next apply
+// tries the patch again.
+func (s *DbSystemResourceCrud) applyPatch() error {
+	oldPatchId, patchId := s.D.GetChange("patch_id")
+	for _, action := range []oci_database.PatchDetailsActionEnum{oci_database.PatchDetailsActionPrecheck, oci_database.PatchDetailsActionApply} {
+		if err := s.patchDbSystem(patchId.(string), action); err != nil {
+			s.D.Set("patch_id", oldPatchId)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DbSystemResourceCrud) patchDbSystem(patchId string, action oci_database.PatchDetailsActionEnum) error {
+	// Right after the update, the last patch history entry of the DB system may still be the entry of an earlier
+	// action, so the entry of this one is the entry for the patch and the action that did not exist before
+	previousEntries, err := s.listPatchHistoryEntries()
+	if err != nil {
+		return err
+	}
+	previousEntryIds := map[string]bool{}
+	for _, entry := range previousEntries {
+		if entry.Id != nil {
+			previousEntryIds[*entry.Id] = true
+		}
+	}
+
+	request := oci_database.UpdateDbSystemRequest{}
+
+	tmp := s.D.Id()
+	request.DbSystemId = &tmp
+
+	request.Version = &oci_database.PatchDetails{
+		PatchId: &patchId,
+		Action:  action,
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DbSystem
+
+	if err := waitForUpdatedState(s, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"",
+			string(oci_database.PatchHistoryEntrySummaryLifecycleStateInProgress),
+		},
+		Target: []string{
+			string(oci_database.PatchHistoryEntrySummaryLifecycleStateSucceeded),
+			string(oci_database.PatchHistoryEntrySummaryLifecycleStateFailed),
+		},
+		Refresh: func() (interface{}, string, error) {
+			entries, err := s.listPatchHistoryEntries()
+			if err != nil {
+				return nil, "", err
+			}
+			for _, entry := range entries {
+				if entry.Id != nil && !previousEntryIds[*entry.Id] && entry.PatchId != nil && *entry.PatchId == patchId && string(entry.Action) == string(action) {
+					return entry, string(entry.LifecycleState), nil
+				}
+			}
+			// The entry is not listed yet
+			return previousEntries, "", nil
+		},
+		Timeout: s.D.Timeout(schema.TimeoutUpdate),
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("%s of patch %s did not complete: %s", strings.ToLower(string(action)), patchId, err)
+	}
+
+	entry := result.(oci_database.PatchHistoryEntrySummary)
+	if entry.LifecycleState == oci_database.PatchHistoryEntrySummaryLifecycleStateFailed {
+		details := ""
+		if entry.LifecycleDetails != nil {
+			details = *entry.LifecycleDetails
+		}
+		return fmt.Errorf("%s of patch %s failed: %s", strings.ToLower(string(action)), patchId, details)
+	}
+
+	return nil
+}
+
+func (s *DbSystemResourceCrud) listPatchHistoryEntries() ([]oci_database.PatchHistoryEntrySummary, error) {
+	request := oci_database.ListDbSystemPatchHistoryEntriesRequest{}
+
+	tmp := s.D.Id()
+	request.DbSystemId = &tmp
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	entries := []oci_database.PatchHistoryEntrySummary{}
+	for {
+		response, err := s.Client.ListDbSystemPatchHistoryEntries(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, response.Items...)
+
+		if response.OpcNextPage == nil {
+			return entries, nil
+		}
+		request.Page = response.OpcNextPage
+	}
+}
+
 func (s *DbSystemResourceCrud) Delete() error {
 	request := oci_database.TerminateDbSystemRequest{}
 
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.TerminateDbSystem(context.Background(), request)
 	return err
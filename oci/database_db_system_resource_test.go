@@ -4,13 +4,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/oracle/oci-go-sdk/database"
 	"github.com/stretchr/testify/suite"
@@ -952,3 +956,102 @@ func (s *ResourceDatabaseDBSystemTestSuite) TestAccResourceDatabaseDBSystem_allV
 func TestResourceDatabaseDBSystemTestSuite(t *testing.T) {
 	suite.Run(t, new(ResourceDatabaseDBSystemTestSuite))
 }
+
+func TestDbSystemResourceApplyPatch(t *testing.T) {
+	for _, applyState := range []string{"SUCCEEDED", "FAILED"} {
+		// The DB system keeps reporting the entry of an earlier patch as its last one
+		entries := []map[string]interface{}{
+			{"id": "entry-1", "patchId": "patch-1", "action": "APPLY", "lifecycleState": "SUCCEEDED", "timeStarted": "2018-08-01T00:00:00.000Z"},
+		}
+		var actions []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/patchHistoryEntries"):
+				body, _ := json.Marshal(entries)
+				w.Write(body)
+				// A new entry is listed as in progress once before it completes
+				for _, entry := range entries {
+					if entry["lifecycleState"] == "IN_PROGRESS" {
+						entry["lifecycleState"] = "SUCCEEDED"
+						if entry["action"] == "APPLY" {
+							entry["lifecycleState"] = applyState
+						}
+					}
+				}
+				return
+			case r.Method == http.MethodPut:
+				var details struct {
+					Version struct{ PatchId, Action string }
+				}
+				json.NewDecoder(r.Body).Decode(&details)
+				actions = append(actions, details.Version.Action)
+				entries = append(entries, map[string]interface{}{"id": fmt.Sprintf("entry-%d", len(entries)+1), "patchId": details.Version.PatchId,
+					"action": details.Version.Action, "lifecycleState": "IN_PROGRESS", "timeStarted": "2018-08-02T00:00:00.000Z", "lifecycleDetails": "patch failed"})
+			}
+			w.Write([]byte(`{"id": "ocid1.dbsystem.oc1..aaaa", "lifecycleState": "AVAILABLE", "lastPatchHistoryEntryId": "entry-1"}`))
+		}))
+		client := &database.DatabaseClient{BaseClient: newTestBaseClient(server)}
+
+		d := schema.TestResourceDataRaw(t, DbSystemResource().Schema, map[string]interface{}{"patch_id": "patch-2"})
+		d.SetId("ocid1.dbsystem.oc1..aaaa")
+		sync := &DbSystemResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+
+		err := sync.applyPatch()
+		server.Close()
+
+		if len(actions) != 2 || actions[0] != "PRECHECK" || actions[1] != "APPLY" {
+			t.Errorf("expected the patch to be prechecked and then applied, got %v", actions)
+		}
+		if applyState == "SUCCEEDED" {
+			if err != nil {
+				t.Errorf("expected the patch to be applied, got %v", err)
+			}
+			if patchId := d.Get("patch_id").(string); patchId != "patch-2" {
+				t.Errorf("expected the patch to be kept in the state, got %q", patchId)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), "apply of patch patch-2 failed: patch failed") {
+			t.Errorf("expected the failure of the apply entry, got %v", err)
+		}
+		if patchId := d.Get("patch_id").(string); patchId != "" {
+			t.Errorf("expected the previous patch to be kept in the state, got %q", patchId)
+		}
+	}
+}
+
+func TestDbSystemResourceCreateWithFailedPatch(t *testing.T) {
+	var patchRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/patchHistoryEntries"):
+			w.Write([]byte(`[]`))
+			return
+		case r.Method == http.MethodPut:
+			patchRequests++
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code": "InvalidParameter", "message": "patch not applicable"}`))
+			return
+		}
+		w.Write([]byte(`{"id": "ocid1.dbsystem.oc1..aaaa", "lifecycleState": "AVAILABLE"}`))
+	}))
+	defer server.Close()
+	client := &database.DatabaseClient{BaseClient: newTestBaseClient(server)}
+
+	d := schema.TestResourceDataRaw(t, DbSystemResource().Schema, map[string]interface{}{"patch_id": "patch-2"})
+
+	if err := createDbSystem(d, &OracleClients{databaseClient: client}); err != nil {
+		t.Errorf("expected the DB system to be created despite the failed patch, got %v", err)
+	}
+	if patchRequests != 1 {
+		t.Errorf("expected the patch to be prechecked once, got %d requests", patchRequests)
+	}
+	if d.Id() != "ocid1.dbsystem.oc1..aaaa" {
+		t.Errorf("expected the DB system to be kept in the state, got the id %q", d.Id())
+	}
+	if patchId := d.Get("patch_id").(string); patchId != "" {
+		t.Errorf("expected the patch not to be recorded in the state, got %q", patchId)
+	}
+}
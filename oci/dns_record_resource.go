@@ -159,7 +159,7 @@ func (s *RecordResourceCrud) Create() error {
 
 	request.Items = []oci_dns.RecordOperation{ro}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 	response, err := s.Client.PatchZoneRecords(context.Background(), request)
 	if err != nil {
 		return err
@@ -202,7 +202,7 @@ func (s *RecordResourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	var err error
 	for true {
@@ -259,7 +259,7 @@ func (s *RecordResourceCrud) Update() error {
 
 	request.Items = []oci_dns.RecordOperation{removeOp, addOp}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 	response, err := s.Client.PatchZoneRecords(context.Background(), request)
 	if err != nil {
 		return err
@@ -298,7 +298,7 @@ func (s *RecordResourceCrud) Delete() error {
 
 	request.Items = []oci_dns.RecordOperation{ro}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 	_, err := s.Client.PatchZoneRecords(context.Background(), request)
 	return err
 }
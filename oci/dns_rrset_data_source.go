@@ -0,0 +1,129 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+func RrsetDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readSingularRrset,
+		Schema: map[string]*schema.Schema{
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rtype": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone_name_or_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     convertResourceFieldsToDatasourceFields(RrsetResource().Schema["items"].Elem.(*schema.Resource)),
+			},
+		},
+	}
+}
+
+func readSingularRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &RrsetDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return ReadResource(sync)
+}
+
+type RrsetDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_dns.DnsClient
+	Res    *oci_dns.GetRRSetResponse
+}
+
+func (s *RrsetDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *RrsetDataSourceCrud) Get() error {
+	request := oci_dns.GetRRSetRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if domain, ok := s.D.GetOkExists("domain"); ok {
+		tmp := domain.(string)
+		request.Domain = &tmp
+	}
+
+	if rtype, ok := s.D.GetOkExists("rtype"); ok {
+		tmp := rtype.(string)
+		request.Rtype = &tmp
+	}
+
+	if zoneNameOrId, ok := s.D.GetOkExists("zone_name_or_id"); ok {
+		tmp := zoneNameOrId.(string)
+		request.ZoneNameOrId = &tmp
+	}
+
+	if zoneVersion, ok := s.D.GetOkExists("zone_version"); ok {
+		tmp := zoneVersion.(string)
+		request.ZoneVersion = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
+
+	response, err := s.Client.GetRRSet(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.GetRRSet(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *RrsetDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+
+	items := []interface{}{}
+	for _, item := range s.Res.Items {
+		items = append(items, RrsetRecordToMap(item))
+	}
+	s.D.Set("items", items)
+
+	return nil
+}
@@ -0,0 +1,382 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+func RrsetResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createRrset,
+		Read:     readRrset,
+		Update:   updateRrset,
+		Delete:   deleteRrset,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"domain": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+			},
+			"rtype": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_name_or_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      rrsetItemsHashCodeForSets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rdata": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rtype": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						// Optional
+
+						// Computed
+						"is_protected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"record_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rrset_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// Computed
+		},
+	}
+}
+
+func createRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &RrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return CreateResource(d, sync)
+}
+
+func readRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &RrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return ReadResource(sync)
+}
+
+func updateRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &RrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return UpdateResource(d, sync)
+}
+
+func deleteRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &RrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+type RrsetResourceCrud struct {
+	BaseCrud
+	Client                 *oci_dns.DnsClient
+	Res                    *oci_dns.RrSet
+	DisableNotFoundRetries bool
+}
+
+func (s *RrsetResourceCrud) ID() string {
+	return getRrsetCompositeId(s.D.Get("domain").(string), s.D.Get("rtype").(string), s.D.Get("zone_name_or_id").(string))
+}
+
+// The whole RRSet is replaced on every write, so creating and updating are the same operation
+func (s *RrsetResourceCrud) Create() error {
+	return s.Update()
+}
+
+func (s *RrsetResourceCrud) Get() error {
+	request := oci_dns.GetRRSetRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	domain, rtype, zoneNameOrId, err := parseRrsetCompositeId(s.D.Id())
+	if err == nil {
+		request.Domain = &domain
+		request.Rtype = &rtype
+		request.ZoneNameOrId = &zoneNameOrId
+	} else {
+		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
+		return err
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	response, err := s.Client.GetRRSet(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RrSet
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.GetRRSet(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *RrsetResourceCrud) Update() error {
+	request := oci_dns.UpdateRRSetRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if domain, ok := s.D.GetOkExists("domain"); ok {
+		tmp := domain.(string)
+		request.Domain = &tmp
+	}
+
+	request.Items = []oci_dns.RecordDetails{}
+	if items, ok := s.D.GetOkExists("items"); ok {
+		set := items.(*schema.Set)
+		interfaces := set.List()
+		tmp := make([]oci_dns.RecordDetails, len(interfaces))
+		for i := range interfaces {
+			stateDataIndex := rrsetItemsHashCodeForSets(interfaces[i])
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "items", stateDataIndex)
+			converted, err := s.mapToRecordDetails(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			tmp[i] = converted
+		}
+		request.Items = tmp
+	}
+
+	if rtype, ok := s.D.GetOkExists("rtype"); ok {
+		tmp := rtype.(string)
+		request.Rtype = &tmp
+	}
+
+	if zoneNameOrId, ok := s.D.GetOkExists("zone_name_or_id"); ok {
+		tmp := zoneNameOrId.(string)
+		request.ZoneNameOrId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &oci_dns.RrSet{Items: response.Items}
+	return nil
+}
+
+func (s *RrsetResourceCrud) Delete() error {
+	request := oci_dns.DeleteRRSetRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if domain, ok := s.D.GetOkExists("domain"); ok {
+		tmp := domain.(string)
+		request.Domain = &tmp
+	}
+
+	if rtype, ok := s.D.GetOkExists("rtype"); ok {
+		tmp := rtype.(string)
+		request.Rtype = &tmp
+	}
+
+	if zoneNameOrId, ok := s.D.GetOkExists("zone_name_or_id"); ok {
+		tmp := zoneNameOrId.(string)
+		request.ZoneNameOrId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	_, err := s.Client.DeleteRRSet(context.Background(), request)
+	return err
+}
+
+func (s *RrsetResourceCrud) SetData() error {
+	domain, rtype, zoneNameOrId, err := parseRrsetCompositeId(s.D.Id())
+	if err == nil {
+		s.D.Set("domain", &domain)
+		s.D.Set("rtype", &rtype)
+		s.D.Set("zone_name_or_id", &zoneNameOrId)
+	} else {
+		log.Printf("[WARN] SetData() unable to parse current ID: %s", s.D.Id())
+	}
+
+	items := []interface{}{}
+	for _, item := range s.Res.Items {
+		items = append(items, RrsetRecordToMap(item))
+	}
+	s.D.Set("items", schema.NewSet(rrsetItemsHashCodeForSets, items))
+
+	return nil
+}
+
+func getRrsetCompositeId(domain string, rtype string, zoneNameOrId string) string {
+	return buildCompositeId("zoneNameOrId", zoneNameOrId, "domain", domain, "rtype", rtype)
+}
+
+func parseRrsetCompositeId(compositeId string) (domain string, rtype string, zoneNameOrId string, err error) {
+	ids, err := parseCompositeId(compositeId, "zoneNameOrId", "domain", "rtype")
+	if err != nil {
+		return
+	}
+	zoneNameOrId, domain, rtype = ids[0], ids[1], ids[2]
+
+	return
+}
+
+func (s *RrsetResourceCrud) mapToRecordDetails(fieldKeyFormat string) (oci_dns.RecordDetails, error) {
+	result := oci_dns.RecordDetails{}
+
+	if domain, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "domain")); ok {
+		tmp := domain.(string)
+		result.Domain = &tmp
+	}
+
+	if rdata, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "rdata")); ok {
+		tmp := rdata.(string)
+		result.Rdata = &tmp
+	}
+
+	if rtype, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "rtype")); ok {
+		tmp := rtype.(string)
+		result.Rtype = &tmp
+	}
+
+	if ttl, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "ttl")); ok {
+		tmp := ttl.(int)
+		result.Ttl = &tmp
+	}
+
+	return result, nil
+}
+
+func RrsetRecordToMap(obj oci_dns.Record) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.Domain != nil {
+		result["domain"] = string(*obj.Domain)
+	}
+
+	if obj.IsProtected != nil {
+		result["is_protected"] = bool(*obj.IsProtected)
+	}
+
+	if obj.Rdata != nil {
+		result["rdata"] = string(*obj.Rdata)
+	}
+
+	if obj.RecordHash != nil {
+		result["record_hash"] = string(*obj.RecordHash)
+	}
+
+	if obj.RrsetVersion != nil {
+		result["rrset_version"] = string(*obj.RrsetVersion)
+	}
+
+	if obj.Rtype != nil {
+		result["rtype"] = string(*obj.Rtype)
+	}
+
+	if obj.Ttl != nil {
+		result["ttl"] = int(*obj.Ttl)
+	}
+
+	return result
+}
+
+// The service may transform rdata (see normalizeRData), so items are hashed on the normalized value to avoid a diff
+func rrsetItemsHashCodeForSets(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	rtype, _ := m["rtype"].(string)
+	if domain, ok := m["domain"]; ok && domain != "" {
+		buf.WriteString(fmt.Sprintf("%v-", strings.ToLower(domain.(string))))
+	}
+	if rtype != "" {
+		buf.WriteString(fmt.Sprintf("%v-", rtype))
+	}
+	if rdata, ok := m["rdata"]; ok && rdata != "" {
+		buf.WriteString(fmt.Sprintf("%v-", normalizeRData(rtype, rdata.(string))))
+	}
+	if ttl, ok := m["ttl"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", ttl))
+	}
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var (
+	rrsetDataSourceRepresentation = map[string]interface{}{
+		"domain":          Representation{repType: Required, create: `${oci_dns_rrset.test_rrset.domain}`},
+		"rtype":           Representation{repType: Required, create: `${oci_dns_rrset.test_rrset.rtype}`},
+		"zone_name_or_id": Representation{repType: Required, create: `${oci_dns_zone.test_zone.name}`},
+		"compartment_id":  Representation{repType: Optional, create: `${var.compartment_id}`},
+	}
+
+	rrsetRepresentation = map[string]interface{}{
+		"domain":          Representation{repType: Required, create: `${data.oci_identity_tenancy.test_tenancy.name}.{{.token}}.oci-record-test`},
+		"rtype":           Representation{repType: Required, create: `A`},
+		"zone_name_or_id": Representation{repType: Required, create: `${oci_dns_zone.test_zone.name}`},
+		"compartment_id":  Representation{repType: Optional, create: `${var.compartment_id}`},
+		"items":           RepresentationGroup{Optional, rrsetItemsRepresentation},
+	}
+	rrsetItemsRepresentation = map[string]interface{}{
+		"domain": Representation{repType: Required, create: `${data.oci_identity_tenancy.test_tenancy.name}.{{.token}}.oci-record-test`},
+		"rdata":  Representation{repType: Required, create: `192.168.0.1`, update: `77.77.77.77`},
+		"rtype":  Representation{repType: Required, create: `A`},
+		"ttl":    Representation{repType: Required, create: `3600`, update: `1000`},
+	}
+
+	RrsetResourceDependencies = RecordResourceDependencies
+)
+
+func TestDnsRrsetResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+	resourceName := "oci_dns_rrset.test_rrset"
+	singularDatasourceName := "data.oci_dns_rrset.test_rrset"
+
+	_, tokenFn := tokenize()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: tokenFn(config+compartmentIdVariableStr+RrsetResourceDependencies+
+					generateResourceFromRepresentationMap("oci_dns_rrset", "test_rrset", Optional, Create, rrsetRepresentation), nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rtype", "A"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "1"),
+					TestCheckResourceAttributesEqual(resourceName, "zone_name_or_id", "oci_dns_zone.test_zone", "name"),
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: tokenFn(config+compartmentIdVariableStr+RrsetResourceDependencies+
+					generateResourceFromRepresentationMap("oci_dns_rrset", "test_rrset", Optional, Update, rrsetRepresentation), nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rtype", "A"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "1"),
+					TestCheckResourceAttributesEqual(resourceName, "zone_name_or_id", "oci_dns_zone.test_zone", "name"),
+				),
+			},
+			// verify singular datasource
+			{
+				Config: tokenFn(config+compartmentIdVariableStr+RrsetResourceDependencies+
+					generateResourceFromRepresentationMap("oci_dns_rrset", "test_rrset", Optional, Update, rrsetRepresentation)+
+					generateDataSourceFromRepresentationMap("oci_dns_rrset", "test_rrset", Optional, Create, rrsetDataSourceRepresentation), nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(singularDatasourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "items.0.rdata", "77.77.77.77"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "items.0.rtype", "A"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "items.0.ttl", "1000"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "items.0.record_hash"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "items.0.rrset_version"),
+				),
+			},
+			// verify resource import
+			{
+				Config:                  tokenFn(config+compartmentIdVariableStr+RrsetResourceDependencies+generateResourceFromRepresentationMap("oci_dns_rrset", "test_rrset", Optional, Update, rrsetRepresentation), nil),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compartment_id"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func TestParseRrsetCompositeId(t *testing.T) {
+	compositeId := getRrsetCompositeId("www.example.com", "A", "example.com")
+
+	domain, rtype, zoneNameOrId, err := parseRrsetCompositeId(compositeId)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %s: %v", compositeId, err)
+	}
+	if domain != "www.example.com" || rtype != "A" || zoneNameOrId != "example.com" {
+		t.Errorf("got domain %q, rtype %q and zone %q from %s", domain, rtype, zoneNameOrId, compositeId)
+	}
+
+	if _, _, _, err := parseRrsetCompositeId("example.com/A"); err == nil {
+		t.Errorf("expected an error for a malformed id")
+	}
+}
+
+func TestRrsetItemsHashCodeForSets(t *testing.T) {
+	configured := map[string]interface{}{"domain": "Www.Example.com", "rdata": "ns1.example.com", "rtype": "CNAME", "ttl": 300}
+	returned := map[string]interface{}{"domain": "www.example.com", "rdata": "ns1.example.com.", "rtype": "CNAME", "ttl": 300, "record_hash": "abc"}
+	if rrsetItemsHashCodeForSets(configured) != rrsetItemsHashCodeForSets(returned) {
+		t.Errorf("expected records that only differ by service normalization to hash the same")
+	}
+
+	returned["ttl"] = 600
+	if rrsetItemsHashCodeForSets(configured) == rrsetItemsHashCodeForSets(returned) {
+		t.Errorf("expected records with different ttls to hash differently")
+	}
+}
@@ -240,7 +240,7 @@ func (s *ZoneResourceCrud) Create() error {
 		request.ZoneType = oci_dns.CreateZoneDetailsZoneTypeEnum(zoneType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.CreateZone(context.Background(), request)
 	if err != nil {
@@ -262,7 +262,7 @@ func (s *ZoneResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.GetZone(context.Background(), request)
 	if err != nil {
@@ -312,7 +312,7 @@ func (s *ZoneResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateZone(context.Background(), request)
 	if err != nil {
@@ -334,7 +334,7 @@ func (s *ZoneResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "dns")
 	_, err := s.Client.DeleteZone(context.Background(), request)
 	return err
 }
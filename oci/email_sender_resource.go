@@ -124,7 +124,7 @@ func (s *SenderResourceCrud) Create() error {
 		request.EmailAddress = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.CreateSender(context.Background(), request)
 	if err != nil {
@@ -141,7 +141,7 @@ func (s *SenderResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SenderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.GetSender(context.Background(), request)
 	if err != nil {
@@ -158,7 +158,7 @@ func (s *SenderResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SenderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	_, err := s.Client.DeleteSender(context.Background(), request)
 	return err
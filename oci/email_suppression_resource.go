@@ -96,7 +96,7 @@ func (s *SuppressionResourceCrud) Create() error {
 		request.EmailAddress = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.CreateSuppression(context.Background(), request)
 	if err != nil {
@@ -113,7 +113,7 @@ func (s *SuppressionResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SuppressionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.GetSuppression(context.Background(), request)
 	if err != nil {
@@ -130,7 +130,7 @@ func (s *SuppressionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SuppressionId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	_, err := s.Client.DeleteSuppression(context.Background(), request)
 	return err
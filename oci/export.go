@@ -0,0 +1,292 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	exportConfigFileName = "oci_export.tf"
+	exportImportFileName = "oci_import.sh"
+)
+
+// exportableResource describes how to discover the resources of a type in a compartment. Resources are listed with
+// the plural data source of the type and each one is then read with the resource itself, like `terraform import` does.
+type exportableResource struct {
+	resourceType   string
+	dataSourceType string
+	listAttrName   string
+	// parentType is set when the data source also needs the id of a resource exported before, e.g. subnets need a VCN
+	parentType     string
+	parentAttrName string
+}
+
+// The order matters, resources are exported after the resources they may reference
+var exportableResources = []exportableResource{
+	{resourceType: "oci_core_vcn", dataSourceType: "oci_core_vcns", listAttrName: "virtual_networks"},
+	{resourceType: "oci_core_subnet", dataSourceType: "oci_core_subnets", listAttrName: "subnets", parentType: "oci_core_vcn", parentAttrName: "vcn_id"},
+	{resourceType: "oci_core_volume", dataSourceType: "oci_core_volumes", listAttrName: "volumes"},
+	{resourceType: "oci_core_instance", dataSourceType: "oci_core_instances", listAttrName: "instances"},
+	{resourceType: "oci_load_balancer_load_balancer", dataSourceType: "oci_load_balancer_load_balancers", listAttrName: "load_balancers"},
+}
+
+var exportSkippedStates = map[string]bool{
+	"TERMINATING": true,
+	"TERMINATED":  true,
+	"DELETING":    true,
+	"DELETED":     true,
+	"FAILED":      true,
+}
+
+type exportedResource struct {
+	resourceType string
+	name         string
+	id           string
+	data         *schema.ResourceData
+}
+
+func (r exportedResource) address() string {
+	return fmt.Sprintf("%s.%s", r.resourceType, r.name)
+}
+
+// RunExportCommand discovers the supported resources of a compartment and writes their configuration and the
+// matching `terraform import` commands to outputPath. The provider is configured from the TF_VAR_ and OCI_
+// environment variables, the same way it is when a provider block leaves arguments unset.
+func RunExportCommand(compartmentId string, outputPath string) error {
+	if compartmentId == "" {
+		return fmt.Errorf("a compartment_id is required to export resources")
+	}
+
+	p := Provider(ProviderConfig).(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfig(nil)); err != nil {
+		return fmt.Errorf("could not configure the provider from the environment: %v", err)
+	}
+
+	exported, err := exportCompartment(p.Meta(), compartmentId)
+	if err != nil {
+		return err
+	}
+
+	config, importCommands := renderExport(exported)
+	if err := ioutil.WriteFile(filepath.Join(outputPath, exportConfigFileName), []byte(config), 0644); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(outputPath, exportImportFileName), []byte(importCommands), 0755); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] exported %d resources from compartment %s to %s", len(exported), compartmentId, outputPath)
+	return nil
+}
+
+func exportCompartment(clients interface{}, compartmentId string) ([]exportedResource, error) {
+	var exported []exportedResource
+	names := map[string]bool{}
+
+	for _, exportable := range exportableResources {
+		parentIds := []string{""}
+		if exportable.parentType != "" {
+			parentIds = nil
+			for _, parent := range exported {
+				if parent.resourceType == exportable.parentType {
+					parentIds = append(parentIds, parent.id)
+				}
+			}
+		}
+
+		for _, parentId := range parentIds {
+			ids, err := listExportableResourceIds(clients, exportable, compartmentId, parentId)
+			if err != nil {
+				return nil, err
+			}
+
+			resource := resourcesMap()[exportable.resourceType]
+			for _, id := range ids {
+				d := resource.Data(nil)
+				d.SetId(id)
+				if err := resource.Read(d, clients); err != nil {
+					return nil, fmt.Errorf("could not read %s %s: %v", exportable.resourceType, id, err)
+				}
+				if d.Id() == "" {
+					continue
+				}
+
+				displayName, _ := d.Get("display_name").(string)
+				name := getExportResourceName(displayName, exportable.resourceType, names)
+				exported = append(exported, exportedResource{exportable.resourceType, name, d.Id(), d})
+			}
+		}
+	}
+
+	return exported, nil
+}
+
+func listExportableResourceIds(clients interface{}, exportable exportableResource, compartmentId string, parentId string) ([]string, error) {
+	dataSource := dataSourcesMap()[exportable.dataSourceType]
+	d := dataSource.Data(nil)
+	d.Set("compartment_id", compartmentId)
+	if exportable.parentAttrName != "" {
+		d.Set(exportable.parentAttrName, parentId)
+	}
+
+	if err := dataSource.Read(d, clients); err != nil {
+		return nil, fmt.Errorf("could not list %s in compartment %s: %v", exportable.resourceType, compartmentId, err)
+	}
+
+	var ids []string
+	for _, item := range d.Get(exportable.listAttrName).([]interface{}) {
+		itemMap := item.(map[string]interface{})
+		if state, ok := itemMap["state"].(string); ok && exportSkippedStates[state] {
+			continue
+		}
+		if id, ok := itemMap["id"].(string); ok && id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+var exportNameInvalidChars = regexp.MustCompile("[^a-z0-9_]+")
+
+// getExportResourceName turns a display name into a unique Terraform resource name
+func getExportResourceName(displayName string, resourceType string, usedNames map[string]bool) string {
+	name := strings.Trim(exportNameInvalidChars.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" {
+		name = strings.TrimPrefix(resourceType, "oci_")
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "r_" + name
+	}
+
+	uniqueName := name
+	for i := 2; usedNames[resourceType+"."+uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s_%d", name, i)
+	}
+	usedNames[resourceType+"."+uniqueName] = true
+	return uniqueName
+}
+
+func renderExport(exported []exportedResource) (string, string) {
+	// OCIDs of exported resources are replaced with references, so that Terraform knows the dependencies
+	references := map[string]string{}
+	for _, r := range exported {
+		references[r.id] = fmt.Sprintf("${%s.id}", r.address())
+	}
+
+	config := &bytes.Buffer{}
+	importCommands := &bytes.Buffer{}
+	importCommands.WriteString("#!/bin/sh\nset -e\n\n")
+
+	for _, r := range exported {
+		fmt.Fprintf(config, "resource %q %q {\n", r.resourceType, r.name)
+		renderExportAttributes(config, resourcesMap()[r.resourceType].Schema, r.data.Get, references, "  ")
+		config.WriteString("}\n\n")
+
+		fmt.Fprintf(importCommands, "terraform import %s %s\n", r.address(), r.id)
+	}
+
+	return config.String(), importCommands.String()
+}
+
+// renderExportAttributes writes the arguments of a resource or a nested block. Computed-only and deprecated
+// attributes are left out since they can not be set in a configuration.
+func renderExportAttributes(buffer *bytes.Buffer, resourceSchema map[string]*schema.Schema, get func(string) interface{}, references map[string]string, indent string) {
+	keys := make([]string, 0, len(resourceSchema))
+	for key := range resourceSchema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		attrSchema := resourceSchema[key]
+		if (!attrSchema.Required && !attrSchema.Optional) || attrSchema.Deprecated != "" || attrSchema.Removed != "" {
+			continue
+		}
+
+		value := get(key)
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		if !attrSchema.Required && isExportZeroValue(value) {
+			continue
+		}
+
+		if elem, ok := attrSchema.Elem.(*schema.Resource); ok {
+			for _, item := range value.([]interface{}) {
+				itemMap, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(buffer, "%s%s {\n", indent, key)
+				renderExportAttributes(buffer, elem.Schema, func(k string) interface{} { return itemMap[k] }, references, indent+"  ")
+				fmt.Fprintf(buffer, "%s}\n", indent)
+			}
+			continue
+		}
+
+		fmt.Fprintf(buffer, "%s%s = %s\n", indent, key, renderExportValue(value, references))
+	}
+}
+
+func renderExportValue(value interface{}, references map[string]string) string {
+	switch v := value.(type) {
+	case string:
+		if reference, ok := references[v]; ok {
+			return strconv.Quote(reference)
+		}
+		// Interpolation sequences in the exported values must not be evaluated
+		return strconv.Quote(strings.Replace(v, "${", "$${", -1))
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, renderExportValue(item, references))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		items := make([]string, 0, len(v))
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%s = %s", strconv.Quote(key), renderExportValue(v[key], references)))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func isExportZeroValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetExportResourceName(t *testing.T) {
+	usedNames := map[string]bool{}
+
+	assert.Equal(t, "my_vcn", getExportResourceName("My VCN", "oci_core_vcn", usedNames))
+	assert.Equal(t, "my_vcn_2", getExportResourceName("my-vcn", "oci_core_vcn", usedNames))
+	assert.Equal(t, "my_vcn", getExportResourceName("My VCN", "oci_core_subnet", usedNames))
+	assert.Equal(t, "core_instance", getExportResourceName("", "oci_core_instance", usedNames))
+	assert.Equal(t, "r_10_0_0_0_16", getExportResourceName("10.0.0.0/16", "oci_core_subnet", usedNames))
+}
+
+func TestRenderExportAttributes(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"compartment_id": {Type: schema.TypeString, Required: true},
+		"vcn_id":         {Type: schema.TypeString, Required: true},
+		"display_name":   {Type: schema.TypeString, Optional: true, Computed: true},
+		"dns_label":      {Type: schema.TypeString, Optional: true},
+		"state":          {Type: schema.TypeString, Computed: true},
+		"cidr_block":     {Type: schema.TypeString, Optional: true, Deprecated: "use cidr_blocks"},
+		"freeform_tags":  {Type: schema.TypeMap, Optional: true},
+		"route_rules": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"destination":       {Type: schema.TypeString, Required: true},
+					"network_entity_id": {Type: schema.TypeString, Required: true},
+				},
+			},
+		},
+	}
+	values := map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaaa",
+		"vcn_id":         "ocid1.vcn.oc1.phx.aaaa",
+		"display_name":   "${not interpolated}",
+		"dns_label":      "",
+		"state":          "AVAILABLE",
+		"cidr_block":     "10.0.0.0/16",
+		"freeform_tags":  map[string]interface{}{"Department": "Finance"},
+		"route_rules": []interface{}{map[string]interface{}{
+			"destination":       "0.0.0.0/0",
+			"network_entity_id": "ocid1.internetgateway.oc1.phx.aaaa",
+		}},
+	}
+	references := map[string]string{"ocid1.vcn.oc1.phx.aaaa": "${oci_core_vcn.my_vcn.id}"}
+
+	buffer := &bytes.Buffer{}
+	renderExportAttributes(buffer, resourceSchema, func(key string) interface{} { return values[key] }, references, "  ")
+
+	assert.Equal(t, `  compartment_id = "ocid1.compartment.oc1..aaaa"
+  display_name = "$${not interpolated}"
+  freeform_tags = {"Department" = "Finance"}
+  route_rules {
+    destination = "0.0.0.0/0"
+    network_entity_id = "ocid1.internetgateway.oc1.phx.aaaa"
+  }
+  vcn_id = "${oci_core_vcn.my_vcn.id}"
+`, buffer.String())
+}
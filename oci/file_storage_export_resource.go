@@ -204,7 +204,7 @@ func (s *ExportResourceCrud) Create() error {
 		request.Path = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.CreateExport(context.Background(), request)
 	if err != nil {
@@ -221,7 +221,7 @@ func (s *ExportResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ExportId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.GetExport(context.Background(), request)
 	if err != nil {
@@ -254,7 +254,7 @@ func (s *ExportResourceCrud) Update() error {
 		request.ExportOptions = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateExport(context.Background(), request)
 	if err != nil {
@@ -271,7 +271,7 @@ func (s *ExportResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExportId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteExport(context.Background(), request)
 	return err
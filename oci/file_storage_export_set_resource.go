@@ -124,7 +124,7 @@ func (s *ExportSetResourceCrud) Create() error {
 		request := oci_file_storage.GetMountTargetRequest{}
 		request.MountTargetId = &tmp
 
-		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+		request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 		response, err := s.Client.GetMountTarget(context.Background(), request)
 		if err != nil {
@@ -150,7 +150,7 @@ func (s *ExportSetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ExportSetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.GetExportSet(context.Background(), request)
 	if err != nil {
@@ -190,7 +190,7 @@ func (s *ExportSetResourceCrud) Update() error {
 		request.MaxFsStatFiles = &tmpInt64
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateExportSet(context.Background(), request)
 	if err != nil {
@@ -169,7 +169,7 @@ func (s *FileSystemResourceCrud) Create() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.CreateFileSystem(context.Background(), request)
 	if err != nil {
@@ -186,7 +186,7 @@ func (s *FileSystemResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.FileSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.GetFileSystem(context.Background(), request)
 	if err != nil {
@@ -220,7 +220,7 @@ func (s *FileSystemResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateFileSystem(context.Background(), request)
 	if err != nil {
@@ -237,7 +237,7 @@ func (s *FileSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FileSystemId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteFileSystem(context.Background(), request)
 	return err
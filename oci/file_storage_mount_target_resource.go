@@ -33,9 +33,10 @@ func MountTargetResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOcidResourceType("subnet"),
 			},
 
 			// Optional
@@ -213,7 +214,7 @@ func (s *MountTargetResourceCrud) Create() error {
 		request.SubnetId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.CreateMountTarget(context.Background(), request)
 	if err != nil {
@@ -230,7 +231,7 @@ func (s *MountTargetResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.MountTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.GetMountTarget(context.Background(), request)
 	if err != nil {
@@ -264,7 +265,7 @@ func (s *MountTargetResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MountTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateMountTarget(context.Background(), request)
 	if err != nil {
@@ -281,7 +282,7 @@ func (s *MountTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MountTargetId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteMountTarget(context.Background(), request)
 	return err
@@ -154,7 +154,7 @@ func (s *SnapshotResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.CreateSnapshot(context.Background(), request)
 	if err != nil {
@@ -171,7 +171,7 @@ func (s *SnapshotResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.GetSnapshot(context.Background(), request)
 	if err != nil {
@@ -200,7 +200,7 @@ func (s *SnapshotResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateSnapshot(context.Background(), request)
 	if err != nil {
@@ -217,7 +217,7 @@ func (s *SnapshotResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteSnapshot(context.Background(), request)
 	return err
@@ -62,7 +62,7 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 		var pathElements []string
 		var err error
 		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
+			log.Print(err.Error())
 			pathElements = []string{keyword}
 		}
 
@@ -3,6 +3,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 
 	"strconv"
 
@@ -49,6 +50,26 @@ func validateNotEmptyString() schema.SchemaValidateFunc {
 	}
 }
 
+// validateOcidResourceType checks that an OCID refers to one of the given resource types, e.g. "ocid1.drg.oc1..."
+func validateOcidResourceType(resourceTypes ...string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		for _, resourceType := range resourceTypes {
+			if strings.HasPrefix(v, fmt.Sprintf("ocid1.%s.", resourceType)) {
+				return
+			}
+		}
+
+		es = append(es, fmt.Errorf("expected %s to be the OCID of one of %v, got %q", k, resourceTypes, v))
+		return
+	}
+}
+
 func objectMapToStringMap(rm map[string]interface{}) map[string]string {
 	result := map[string]string{}
 	for k, v := range rm {
@@ -81,6 +102,21 @@ func int64StringDiffSuppressFunction(key string, old string, new string, d *sche
 	return oldIntVal == newIntVal
 }
 
+// int64StringDecreased can be used with customdiff.ForceNewIfChange for int64 string fields that can only grow in place.
+// Values that cannot be parsed, such as an unset old value or interpolation syntax, are not treated as a decrease.
+func int64StringDecreased(old, new, meta interface{}) bool {
+	oldIntVal, err := strconv.ParseInt(old.(string), 10, 64)
+	if err != nil {
+		return false
+	}
+
+	newIntVal, err := strconv.ParseInt(new.(string), 10, 64)
+	if err != nil {
+		return false
+	}
+	return newIntVal < oldIntVal
+}
+
 func convertMapOfStringSlicesToMapOfStrings(rm map[string][]string) (map[string]string, error) {
 	result := map[string]string{}
 	for k, v := range rm {
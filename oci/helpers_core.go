@@ -6,6 +6,8 @@ import (
 	"context"
 
 	"fmt"
+	"net"
+	"regexp"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/core"
@@ -39,6 +41,55 @@ func ImportDefaultVcnResource(d *schema.ResourceData, value interface{}) ([]*sch
 	return []*schema.ResourceData{d}, err
 }
 
+// DNS labels for VCNs and subnets must start with a letter, contain only letters and digits, and be at most 15 characters long
+var dnsLabelRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,14}$`)
+
+func validateDnsLabel(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// An empty label means DNS resolution is not enabled, so let the service handle it.
+	if v != "" && !dnsLabelRegexp.MatchString(v) {
+		es = append(es, fmt.Errorf("%s must be an alphanumeric string of at most 15 characters that begins with a letter, got %q", k, v))
+	}
+	return
+}
+
+func validateCidrBlock(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	ip, _, err := net.ParseCIDR(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s must be a valid IPv4 CIDR block, got %q: %v", k, v, err))
+	} else if ip.To4() == nil {
+		es = append(es, fmt.Errorf("%s must be a valid IPv4 CIDR block, got the IPv6 CIDR block %q", k, v))
+	}
+	return
+}
+
+// cidrBlockContains returns true if every address in the inner CIDR block is also in the outer one
+func cidrBlockContains(outer string, inner string) (bool, error) {
+	_, outerNet, err := net.ParseCIDR(outer)
+	if err != nil {
+		return false, err
+	}
+	_, innerNet, err := net.ParseCIDR(inner)
+	if err != nil {
+		return false, err
+	}
+
+	outerOnes, _ := outerNet.Mask.Size()
+	innerOnes, _ := innerNet.Mask.Size()
+	return outerNet.Contains(innerNet.IP) && outerOnes <= innerOnes, nil
+}
+
 func LaunchOptionsToMap(obj *oci_core.LaunchOptions) map[string]interface{} {
 	result := map[string]interface{}{}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"testing"
 	"time"
 
 	oci_common "github.com/oracle/oci-go-sdk/common"
@@ -175,3 +176,59 @@ func volumeBackupAvailableWaitCondition(response oci_common.OCIOperationResponse
 
 	return false
 }
+
+func TestValidateDnsLabel(t *testing.T) {
+	validLabels := []string{"", "vcn1", "Subnet123", "a", "abcdefghijklmno"}
+	for _, label := range validLabels {
+		if _, errs := validateDnsLabel(label, "dns_label"); len(errs) != 0 {
+			t.Errorf("expected %q to be a valid dns_label, got %v", label, errs)
+		}
+	}
+
+	invalidLabels := []string{"1vcn", "my-vcn", "my_vcn", "abcdefghijklmnop", "vcn.example"}
+	for _, label := range invalidLabels {
+		if _, errs := validateDnsLabel(label, "dns_label"); len(errs) == 0 {
+			t.Errorf("expected %q to be an invalid dns_label", label)
+		}
+	}
+}
+
+func TestCidrBlockContains(t *testing.T) {
+	testCases := []struct {
+		outer    string
+		inner    string
+		expected bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.1.0.0/24", false},
+		{"10.0.0.0/16", "10.0.0.0/8", false},
+	}
+
+	for _, testCase := range testCases {
+		result, err := cidrBlockContains(testCase.outer, testCase.inner)
+		if err != nil {
+			t.Errorf("unexpected error for %s in %s: %v", testCase.inner, testCase.outer, err)
+		}
+		if result != testCase.expected {
+			t.Errorf("expected %s in %s to be %t, got %t", testCase.inner, testCase.outer, testCase.expected, result)
+		}
+	}
+
+	if _, err := cidrBlockContains("10.0.0.0/16", "10.0.0.0"); err == nil {
+		t.Errorf("expected an error for an invalid CIDR block")
+	}
+}
+
+func TestValidateCidrBlock(t *testing.T) {
+	if _, errs := validateCidrBlock("10.0.0.0/16", "cidr_block"); len(errs) != 0 {
+		t.Errorf("expected 10.0.0.0/16 to be a valid cidr_block, got %v", errs)
+	}
+
+	invalidCidrBlocks := []string{"10.0.0.0", "10.0.0.0/33", "2001:db8::/32"}
+	for _, cidrBlock := range invalidCidrBlocks {
+		if _, errs := validateCidrBlock(cidrBlock, "cidr_block"); len(errs) == 0 {
+			t.Errorf("expected %q to be an invalid cidr_block", cidrBlock)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Diff suppression functions for values that the services return in a different but equivalent form. Case-insensitive
+// values use EqualIgnoreCaseSuppressDiff.
+
+// timestampFormats are the formats of the timestamps in a configuration or the state. Configurations use RFC3339, and
+// some resources set the String() of the SDK time in the state.
+var timestampFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+}
+
+func parseTimestamp(value string) (time.Time, bool) {
+	for _, format := range timestampFormats {
+		if t, err := time.Parse(format, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// timestampDiffSuppressFunction suppresses the diff between two representations of the same instant, e.g.
+// "2019-01-01T01:00:00+01:00" and "2019-01-01T00:00:00Z"
+func timestampDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	oldTime, ok := parseTimestamp(old)
+	if !ok {
+		return false
+	}
+	newTime, ok := parseTimestamp(new)
+	if !ok {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// jsonStringDiffSuppressFunction suppresses the diff between JSON documents that only differ by formatting or the
+// order of the keys
+func jsonStringDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// base64ContentDiffSuppressFunction suppresses the diff between base64 encoded content and the same content in plain
+// text. Values that are valid base64 are treated as encoded.
+func base64ContentDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	return old == new || decodeBase64Content(old) == decodeBase64Content(new)
+}
+
+func decodeBase64Content(value string) string {
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return string(decoded)
+	}
+	return value
+}
+
+// policyStatementKeywords are the words of the policy language, which are not case sensitive. Names, resource types
+// and the values in conditions are compared as they are.
+var policyStatementKeywords = map[string]bool{
+	"allow": true, "define": true, "endorse": true, "admit": true, "group": true, "dynamic-group": true,
+	"any-user": true, "service": true, "to": true, "inspect": true, "read": true, "use": true, "manage": true,
+	"in": true, "tenancy": true, "compartment": true, "where": true, "all": true, "any": true, "and": true, "or": true,
+	"of": true, "as": true, "all-resources": true,
+}
+
+// policyStatementDiffSuppressFunction suppresses the diff between policy statements that only differ by spacing or by
+// the case of the keywords, like statements the service reformats
+func policyStatementDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	oldTokens := tokenizePolicyStatement(old)
+	newTokens := tokenizePolicyStatement(new)
+	if len(oldTokens) != len(newTokens) {
+		return false
+	}
+	for i := range oldTokens {
+		if oldTokens[i] == newTokens[i] {
+			continue
+		}
+		if !policyStatementKeywords[strings.ToLower(oldTokens[i])] || !strings.EqualFold(oldTokens[i], newTokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenizePolicyStatement splits a statement on whitespace, except inside quoted values
+func tokenizePolicyStatement(statement string) []string {
+	var tokens []string
+	var token []rune
+	var quote rune
+	for _, r := range statement {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			token = append(token, r)
+		case r == '\'' || r == '"':
+			quote = r
+			token = append(token, r)
+		case unicode.IsSpace(r):
+			if len(token) > 0 {
+				tokens = append(tokens, string(token))
+				token = nil
+			}
+		default:
+			token = append(token, r)
+		}
+	}
+	if len(token) > 0 {
+		tokens = append(tokens, string(token))
+	}
+	return tokens
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestTimestampDiffSuppressFunction(t *testing.T) {
+	if !timestampDiffSuppressFunction("time_expires", "2019-01-01 00:00:00 +0000 UTC", "2019-01-01T01:00:00+01:00", nil) {
+		t.Errorf("expected timestamps of the same instant to be equal")
+	}
+	if !timestampDiffSuppressFunction("time_expires", "2019-01-01T00:00:00.000Z", "2019-01-01T00:00:00Z", nil) {
+		t.Errorf("expected timestamps with and without fractional seconds to be equal")
+	}
+	if timestampDiffSuppressFunction("time_expires", "2019-01-01T00:00:00Z", "2019-01-02T00:00:00Z", nil) {
+		t.Errorf("expected different timestamps to not be equal")
+	}
+	if timestampDiffSuppressFunction("time_expires", "2019-01-01T00:00:00Z", "${var.time_expires}", nil) {
+		t.Errorf("expected values that are not timestamps to not be equal")
+	}
+}
+
+func TestJsonStringDiffSuppressFunction(t *testing.T) {
+	if !jsonStringDiffSuppressFunction("document", `{"a": 1, "b": [true, null]}`, `{"b":[true,null],"a":1}`, nil) {
+		t.Errorf("expected JSON documents differing only in formatting to be equal")
+	}
+	if jsonStringDiffSuppressFunction("document", `{"a": 1}`, `{"a": "1"}`, nil) {
+		t.Errorf("expected different JSON documents to not be equal")
+	}
+	if jsonStringDiffSuppressFunction("document", `{"a": 1}`, `{"a": 1`, nil) {
+		t.Errorf("expected invalid JSON to not be equal")
+	}
+}
+
+func TestBase64ContentDiffSuppressFunction(t *testing.T) {
+	if !base64ContentDiffSuppressFunction("content", "IyFiaW4vYmFzaAplY2hvIGhlbGxvCg==", "#!bin/bash\necho hello\n", nil) {
+		t.Errorf("expected base64 encoded content to be equal to the same plain text content")
+	}
+	if base64ContentDiffSuppressFunction("content", "IyFiaW4vYmFzaAplY2hvIGhlbGxvCg==", "#!bin/bash\necho goodbye\n", nil) {
+		t.Errorf("expected different content to not be equal")
+	}
+}
+
+func TestPolicyStatementDiffSuppressFunction(t *testing.T) {
+	if !policyStatementDiffSuppressFunction("statements.0", "allow group A to read all-resources in tenancy", " Allow GROUP  A to Read all-resources IN tenancy", nil) {
+		t.Errorf("expected statements differing only in spacing and in the case of keywords to be equal")
+	}
+	if policyStatementDiffSuppressFunction("statements.0", "allow group a to read all-resources in tenancy", "allow group a to manage all-resources in tenancy", nil) {
+		t.Errorf("expected different statements to not be equal")
+	}
+	if policyStatementDiffSuppressFunction("statements.0", "allow group A to read all-resources in tenancy", "allow group a to read all-resources in tenancy", nil) {
+		t.Errorf("expected statements with a different group name to not be equal")
+	}
+	if policyStatementDiffSuppressFunction("statements.0", "allow any-user to read objects in tenancy where request.user.name = 'Bob'", "allow any-user to read objects in tenancy where request.user.name = 'bob'", nil) {
+		t.Errorf("expected statements with a different condition value to not be equal")
+	}
+	if policyStatementDiffSuppressFunction("statements.0", "allow any-user to read objects in tenancy where target.bucket.name = 'a  b'", "allow any-user to read objects in tenancy where target.bucket.name = 'a b'", nil) {
+		t.Errorf("expected the spacing of quoted values to be compared")
+	}
+}
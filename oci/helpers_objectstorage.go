@@ -419,6 +419,7 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 	retryPolicy := getRetryPolicy(disableFoundRetries, "object_storage")
 	retryPolicy.ShouldRetryOperation = objectStorageWorkRequestShouldRetryFunc(timeout)
 
+	progress := newWaitProgressLogger(fmt.Sprintf("object storage work request %s", *wId))
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			string(oci_object_storage.WorkRequestStatusAccepted),
@@ -436,6 +437,9 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
 			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 			wr := &workRequestResponse.WorkRequest
+			if err == nil && wr.PercentComplete != nil {
+				progress.log(fmt.Sprintf("%s, %.0f%% complete", wr.Status, *wr.PercentComplete))
+			}
 			return workRequestResponse, string(wr.Status), err
 		},
 		Timeout: timeout,
@@ -467,7 +471,7 @@ func objectStorageWorkRequestShouldRetryFunc(timeout time.Duration) func(respons
 		}
 
 		//Make sure we stop on default rules
-		if shouldRetry(response, false, "object_storage", startTime) {
+		if shouldRetry(response, false, "object_storage", startTime, time.Time{}) {
 			return true
 		}
 
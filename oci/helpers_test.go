@@ -0,0 +1,47 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestValidateOcidResourceType(t *testing.T) {
+	validateFunc := validateOcidResourceType("internetgateway", "drg")
+
+	validOcids := []string{
+		"ocid1.internetgateway.oc1.phx.aaaaaaaa",
+		"ocid1.drg.oc1.phx.aaaaaaaa",
+	}
+	for _, ocid := range validOcids {
+		if _, errs := validateFunc(ocid, "network_entity_id"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", ocid, errs)
+		}
+	}
+
+	invalidOcids := []string{
+		"",
+		"ocid1.subnet.oc1.phx.aaaaaaaa",
+		"ocid1.drgattachment.oc1.phx.aaaaaaaa",
+		"internetgateway",
+	}
+	for _, ocid := range invalidOcids {
+		if _, errs := validateFunc(ocid, "network_entity_id"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", ocid)
+		}
+	}
+}
+
+func TestInt64StringDecreased(t *testing.T) {
+	if !int64StringDecreased("100", "50", nil) {
+		t.Errorf("expected a change from 100 to 50 to be a decrease")
+	}
+
+	if int64StringDecreased("50", "100", nil) {
+		t.Errorf("expected a change from 50 to 100 to not be a decrease")
+	}
+
+	if int64StringDecreased("", "50", nil) {
+		t.Errorf("expected an unset old value to not be a decrease")
+	}
+}
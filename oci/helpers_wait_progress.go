@@ -0,0 +1,35 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"log"
+	"time"
+)
+
+// waitProgressInterval is how often the progress of a long wait is logged when it has not changed
+var waitProgressInterval = time.Minute
+
+// waitProgressLogger logs the progress of a work request or of a resource state while waiting for it, so that a long
+// apply can be told apart from a hung one. Progress is logged at the INFO level when it changes, and every
+// waitProgressInterval otherwise.
+type waitProgressLogger struct {
+	description string
+	start       time.Time
+	lastLogged  time.Time
+	last        string
+}
+
+func newWaitProgressLogger(description string) *waitProgressLogger {
+	return &waitProgressLogger{description: description, start: time.Now()}
+}
+
+func (l *waitProgressLogger) log(progress string) {
+	now := time.Now()
+	if progress == l.last && now.Sub(l.lastLogged) < waitProgressInterval {
+		return
+	}
+	log.Printf("[INFO] %s: %s (%s elapsed)", l.description, progress, now.Sub(l.start).Truncate(time.Second))
+	l.last = progress
+	l.lastLogged = now
+}
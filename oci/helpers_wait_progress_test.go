@@ -0,0 +1,37 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitProgressLogger(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log.SetOutput(buffer)
+	defer log.SetOutput(os.Stderr)
+	defer func(interval time.Duration) { waitProgressInterval = interval }(waitProgressInterval)
+	waitProgressInterval = 50 * time.Millisecond
+
+	progress := newWaitProgressLogger("load balancer work request ocid1.loadbalancerworkrequest.oc1..aaaa")
+	progress.log("IN_PROGRESS, Creating backend set bs1")
+	progress.log("IN_PROGRESS, Creating backend set bs1")
+	progress.log("IN_PROGRESS, Creating listener http")
+	if lines := strings.Count(buffer.String(), "\n"); lines != 2 {
+		t.Errorf("expected the progress to be logged only when it changes, got %d lines: %s", lines, buffer.String())
+	}
+	if !strings.Contains(buffer.String(), "[INFO] load balancer work request ocid1.loadbalancerworkrequest.oc1..aaaa: IN_PROGRESS, Creating listener http (0s elapsed)") {
+		t.Errorf("unexpected progress message: %s", buffer.String())
+	}
+
+	time.Sleep(waitProgressInterval)
+	progress.log("IN_PROGRESS, Creating listener http")
+	if lines := strings.Count(buffer.String(), "\n"); lines != 3 {
+		t.Errorf("expected unchanged progress to be logged again after the interval, got %d lines: %s", lines, buffer.String())
+	}
+}
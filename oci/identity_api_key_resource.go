@@ -138,7 +138,7 @@ func (s *ApiKeyResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UploadApiKey(context.Background(), request)
 	if err != nil {
@@ -157,7 +157,7 @@ func (s *ApiKeyResourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.ListApiKeys(context.Background(), request)
 	if err != nil {
@@ -188,7 +188,7 @@ func (s *ApiKeyResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteApiKey(context.Background(), request)
 	return err
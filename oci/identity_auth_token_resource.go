@@ -51,8 +51,9 @@ func AuthTokenResource() *schema.Resource {
 				Computed: true,
 			},
 			"token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 		},
 	}
@@ -143,7 +144,7 @@ func (s *AuthTokenResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateAuthToken(context.Background(), request)
 	if err != nil {
@@ -162,7 +163,7 @@ func (s *AuthTokenResourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.ListAuthTokens(context.Background(), request)
 	if err != nil {
@@ -196,7 +197,7 @@ func (s *AuthTokenResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateAuthToken(context.Background(), request)
 	if err != nil {
@@ -218,7 +219,7 @@ func (s *AuthTokenResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteAuthToken(context.Background(), request)
 	return err
@@ -51,6 +51,7 @@ func readAvailabilityDomains(d *schema.ResourceData, m interface{}) error {
 	sync := &AvailabilityDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
+	sync.Cache = m.(*OracleClients).lookupCache
 
 	return ReadResource(sync)
 }
@@ -58,6 +59,7 @@ func readAvailabilityDomains(d *schema.ResourceData, m interface{}) error {
 type AvailabilityDomainsDataSourceCrud struct {
 	D      *schema.ResourceData
 	Client *oci_identity.IdentityClient
+	Cache  *lookupCache
 	Res    *oci_identity.ListAvailabilityDomainsResponse
 }
 
@@ -75,12 +77,16 @@ func (s *AvailabilityDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	// The availability domains of a tenancy do not change while Terraform runs
+	response, err := s.Cache.get("availability_domains/"+*request.CompartmentId, func() (interface{}, error) {
+		return s.Client.ListAvailabilityDomains(context.Background(), request)
+	})
 	if err != nil {
 		return err
 	}
 
-	s.Res = &response
+	res := response.(oci_identity.ListAvailabilityDomainsResponse)
+	s.Res = &res
 	return nil
 }
 
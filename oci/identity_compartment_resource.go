@@ -212,7 +212,7 @@ func (s *CompartmentResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateCompartment(context.Background(), request)
 	if err != nil {
@@ -271,7 +271,7 @@ func (s *CompartmentResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.CompartmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetCompartment(context.Background(), request)
 	if err != nil {
@@ -310,7 +310,7 @@ func (s *CompartmentResourceCrud) Update() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateCompartment(context.Background(), request)
 	if err != nil {
@@ -327,7 +327,7 @@ func (s *CompartmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CompartmentId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteCompartment(context.Background(), request)
 	return err
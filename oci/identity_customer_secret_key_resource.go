@@ -143,7 +143,7 @@ func (s *CustomerSecretKeyResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateCustomerSecretKey(context.Background(), request)
 	if err != nil {
@@ -162,7 +162,7 @@ func (s *CustomerSecretKeyResourceCrud) Get() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
 	if err != nil {
@@ -196,7 +196,7 @@ func (s *CustomerSecretKeyResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateCustomerSecretKey(context.Background(), request)
 	if err != nil {
@@ -218,7 +218,7 @@ func (s *CustomerSecretKeyResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteCustomerSecretKey(context.Background(), request)
 	return err
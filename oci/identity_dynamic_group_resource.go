@@ -152,7 +152,7 @@ func (s *DynamicGroupResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateDynamicGroup(context.Background(), request)
 	if err != nil {
@@ -169,7 +169,7 @@ func (s *DynamicGroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.DynamicGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetDynamicGroup(context.Background(), request)
 	if err != nil {
@@ -196,7 +196,7 @@ func (s *DynamicGroupResourceCrud) Update() error {
 		request.MatchingRule = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateDynamicGroup(context.Background(), request)
 	if err != nil {
@@ -213,7 +213,7 @@ func (s *DynamicGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DynamicGroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteDynamicGroup(context.Background(), request)
 	return err
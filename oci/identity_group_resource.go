@@ -198,7 +198,7 @@ func (s *GroupResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateGroup(context.Background(), request)
 	if err != nil {
@@ -215,7 +215,7 @@ func (s *GroupResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetGroup(context.Background(), request)
 	if err != nil {
@@ -249,7 +249,7 @@ func (s *GroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateGroup(context.Background(), request)
 	if err != nil {
@@ -266,7 +266,7 @@ func (s *GroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteGroup(context.Background(), request)
 	return err
@@ -185,7 +185,7 @@ func (s *IdentityProviderResourceCrud) Create() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateIdentityProvider(context.Background(), request)
 	if err != nil {
@@ -202,7 +202,7 @@ func (s *IdentityProviderResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.IdentityProviderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetIdentityProvider(context.Background(), request)
 	if err != nil {
@@ -220,7 +220,7 @@ func (s *IdentityProviderResourceCrud) Update() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateIdentityProvider(context.Background(), request)
 	if err != nil {
@@ -237,7 +237,7 @@ func (s *IdentityProviderResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IdentityProviderId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteIdentityProvider(context.Background(), request)
 	return err
@@ -13,6 +13,9 @@ import (
 
 func IdpGroupMappingResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdpGroupMapping,
 		Read:     readIdpGroupMapping,
@@ -143,7 +146,7 @@ func (s *IdpGroupMappingResourceCrud) Create() error {
 		request.IdpGroupName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateIdpGroupMapping(context.Background(), request)
 	if err != nil {
@@ -165,7 +168,13 @@ func (s *IdpGroupMappingResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.MappingId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	identityProviderId, mappingId, parseIdpGroupMappingCompositeIdErr := parseIdpGroupMappingCompositeId(s.D.Id())
+	if parseIdpGroupMappingCompositeIdErr == nil {
+		request.IdentityProviderId = &identityProviderId
+		request.MappingId = &mappingId
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetIdpGroupMapping(context.Background(), request)
 	if err != nil {
@@ -173,6 +182,10 @@ func (s *IdpGroupMappingResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IdpGroupMapping
+	if parseIdpGroupMappingCompositeIdErr == nil {
+		// Import sets the ID to composite ID and hence overwriting ID to OCID from response
+		s.D.SetId(mappingId)
+	}
 	return nil
 }
 
@@ -197,7 +210,7 @@ func (s *IdpGroupMappingResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MappingId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateIdpGroupMapping(context.Background(), request)
 	if err != nil {
@@ -219,7 +232,7 @@ func (s *IdpGroupMappingResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MappingId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteIdpGroupMapping(context.Background(), request)
 	return err
@@ -254,3 +267,13 @@ func (s *IdpGroupMappingResourceCrud) SetData() error {
 
 	return nil
 }
+
+func parseIdpGroupMappingCompositeId(compositeId string) (identityProviderId string, mappingId string, err error) {
+	ids, err := parseCompositeId(compositeId, "identityProviders", "groupMappings")
+	if err != nil {
+		return
+	}
+	identityProviderId, mappingId = ids[0], ids[1]
+
+	return
+}
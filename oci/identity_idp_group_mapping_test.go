@@ -112,10 +112,44 @@ func TestIdentityIdpGroupMappingResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "idp_group_mappings.0.time_created"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportStateIdFunc:       getIdpGroupMappingCompositeId(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
 
+func getIdpGroupMappingCompositeId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("identityProviders/%s/groupMappings/%s", rs.Primary.Attributes["identity_provider_id"], rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestParseIdpGroupMappingCompositeId(t *testing.T) {
+	identityProviderId, mappingId, err := parseIdpGroupMappingCompositeId("identityProviders/ocid1.saml2idp.oc1..aaaa/groupMappings/ocid1.idpgroupmapping.oc1..bbbb")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if identityProviderId != "ocid1.saml2idp.oc1..aaaa" || mappingId != "ocid1.idpgroupmapping.oc1..bbbb" {
+		t.Errorf("got identity provider %q and mapping %q", identityProviderId, mappingId)
+	}
+
+	if _, _, err := parseIdpGroupMappingCompositeId("ocid1.idpgroupmapping.oc1..bbbb"); err == nil {
+		t.Errorf("expected an error for a plain mapping OCID")
+	}
+}
+
 func testAccCheckIdentityIdpGroupMappingDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).identityClient
@@ -72,6 +72,10 @@ func PolicyResource() *schema.Resource {
 			},
 
 			// Computed
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"inactive_state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -235,7 +239,7 @@ func (s *PolicyResourceCrud) Create() error {
 		request.VersionDate = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreatePolicy(context.Background(), request)
 	if err != nil {
@@ -259,7 +263,7 @@ func (s *PolicyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetPolicy(context.Background(), request)
 	if err != nil {
@@ -267,6 +271,7 @@ func (s *PolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Policy
+	s.setETag(response.Etag)
 
 	// update etag on a successful get
 	s.D.Set("ETag", response.Etag)
@@ -317,7 +322,9 @@ func (s *PolicyResourceCrud) Update() error {
 		request.VersionDate = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdatePolicy(context.Background(), request)
 	if err != nil {
@@ -325,6 +332,7 @@ func (s *PolicyResourceCrud) Update() error {
 	}
 
 	s.Res = &response.Policy
+	s.setWrittenETag(response.Etag)
 
 	// if the response was successful, store off policy hash and etag
 	statements := toStringArray(s.D.Get("statements").([]interface{}))
@@ -341,7 +349,9 @@ func (s *PolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.IfMatch = s.getIfMatch()
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeletePolicy(context.Background(), request)
 	return err
@@ -386,6 +396,11 @@ func (s *PolicyResourceCrud) SetData() error {
 }
 
 func ignorePolicyFormatDiff(k string, old string, new string, d *schema.ResourceData) bool {
+	// The service may change the spacing of a statement and the case of its keywords, neither of which changes its meaning
+	if policyStatementDiffSuppressFunction(k, old, new, d) {
+		return true
+	}
+
 	oldHash := getOrDefault(d, "policyHash", "")
 	newHash := getMD5Hash(toStringArray(d.Get("statements")))
 	oldETag := getOrDefault(d, "lastUpdateETag", "")
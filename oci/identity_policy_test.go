@@ -286,3 +286,19 @@ func policySweepResponseFetchOperation(client *OracleClients, resourceId *string
 	})
 	return err
 }
+
+func TestPolicyStatementDiffSuppressFunctionNormalization(t *testing.T) {
+	configured := "Allow group  Administrators to manage all-resources in tenancy"
+	returned := "allow group Administrators to manage all-resources in tenancy"
+	if !policyStatementDiffSuppressFunction("statements.0", returned, configured, nil) {
+		t.Errorf("expected statements differing only in spacing and in the case of keywords to be equal")
+	}
+
+	if policyStatementDiffSuppressFunction("statements.0", "Allow group Administrators to read all-resources in tenancy", configured, nil) {
+		t.Errorf("expected statements with different verbs to not be equal")
+	}
+
+	if policyStatementDiffSuppressFunction("statements.0", "allow group administrators to manage all-resources in tenancy", configured, nil) {
+		t.Errorf("expected statements with a different case in the group name to not be equal")
+	}
+}
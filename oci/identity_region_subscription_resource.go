@@ -0,0 +1,193 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_identity "github.com/oracle/oci-go-sdk/identity"
+)
+
+func RegionSubscriptionResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createRegionSubscription,
+		Read:     readRegionSubscription,
+		Delete:   deleteRegionSubscription,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"region_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+			},
+			"tenancy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+
+			// Computed
+			"is_home_region": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createRegionSubscription(d *schema.ResourceData, m interface{}) error {
+	sync := &RegionSubscriptionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return CreateResource(d, sync)
+}
+
+func readRegionSubscription(d *schema.ResourceData, m interface{}) error {
+	sync := &RegionSubscriptionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return ReadResource(sync)
+}
+
+// Region subscriptions cannot be removed from a tenancy, so destroying the resource only removes it from the state
+func deleteRegionSubscription(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type RegionSubscriptionResourceCrud struct {
+	BaseCrud
+	Client                 *oci_identity.IdentityClient
+	Res                    *oci_identity.RegionSubscription
+	DisableNotFoundRetries bool
+}
+
+func (s *RegionSubscriptionResourceCrud) ID() string {
+	return *s.Res.RegionKey
+}
+
+// Region subscriptions report their lifecycle in Status rather than LifecycleState, which the default implementation can't find
+func (s *RegionSubscriptionResourceCrud) setState(sync StatefulResource) error {
+	return s.D.Set("state", string(s.Res.Status))
+}
+
+func (s *RegionSubscriptionResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_identity.RegionSubscriptionStatusInProgress),
+	}
+}
+
+func (s *RegionSubscriptionResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_identity.RegionSubscriptionStatusReady),
+	}
+}
+
+func (s *RegionSubscriptionResourceCrud) Create() error {
+	request := oci_identity.CreateRegionSubscriptionRequest{}
+
+	if regionKey, ok := s.D.GetOkExists("region_key"); ok {
+		tmp := regionKey.(string)
+		request.RegionKey = &tmp
+	}
+
+	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
+		tmp := tenancyId.(string)
+		request.TenancyId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.CreateRegionSubscription(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RegionSubscription
+	return nil
+}
+
+func (s *RegionSubscriptionResourceCrud) Get() error {
+	request := oci_identity.ListRegionSubscriptionsRequest{}
+
+	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
+		tmp := tenancyId.(string)
+		request.TenancyId = &tmp
+	}
+
+	regionKey := s.D.Id()
+	tenancyId, compositeRegionKey, parseRegionSubscriptionCompositeIdErr := parseRegionSubscriptionCompositeId(s.D.Id())
+	if parseRegionSubscriptionCompositeIdErr == nil {
+		request.TenancyId = &tenancyId
+		regionKey = compositeRegionKey
+	}
+
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.ListRegionSubscriptions(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range response.Items {
+		if item.RegionKey != nil && strings.EqualFold(*item.RegionKey, regionKey) {
+			s.Res = &item
+			if parseRegionSubscriptionCompositeIdErr == nil {
+				// Import sets the ID to composite ID, the tenancy is not part of the subscription so it is kept from the ID
+				s.D.Set("tenancy_id", tenancyId)
+				s.D.SetId(*item.RegionKey)
+			}
+			return nil
+		}
+	}
+	// Recognized by handleMissingResourceError, so that a region the tenancy is not subscribed to is removed from the state
+	return fmt.Errorf("region subscription %s does not exist", regionKey)
+}
+
+func (s *RegionSubscriptionResourceCrud) SetData() error {
+	if s.Res.IsHomeRegion != nil {
+		s.D.Set("is_home_region", *s.Res.IsHomeRegion)
+	}
+
+	if s.Res.RegionKey != nil {
+		s.D.Set("region_key", *s.Res.RegionKey)
+	}
+
+	if s.Res.RegionName != nil {
+		s.D.Set("region_name", *s.Res.RegionName)
+	}
+
+	s.D.Set("state", s.Res.Status)
+
+	return nil
+}
+
+func parseRegionSubscriptionCompositeId(compositeId string) (tenancyId string, regionKey string, err error) {
+	ids, err := parseCompositeId(compositeId, "tenancies", "regionSubscriptions")
+	if err != nil {
+		return
+	}
+	tenancyId, regionKey = ids[0], ids[1]
+
+	return
+}
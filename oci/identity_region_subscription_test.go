@@ -3,10 +3,13 @@
 package provider
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	oci_identity "github.com/oracle/oci-go-sdk/identity"
 )
 
 var (
@@ -49,3 +52,75 @@ func TestIdentityRegionSubscriptionResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestRegionSubscriptionResourceSchema(t *testing.T) {
+	r := RegionSubscriptionResource()
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("unexpected schema error: %v", err)
+	}
+	if r.Update != nil {
+		t.Errorf("expected region subscriptions not to be updatable")
+	}
+	for _, name := range []string{"region_key", "tenancy_id"} {
+		if !r.Schema[name].Required || !r.Schema[name].ForceNew {
+			t.Errorf("expected %s to be required and to force a new subscription", name)
+		}
+	}
+	for _, name := range []string{"is_home_region", "region_name", "state"} {
+		if !r.Schema[name].Computed || r.Schema[name].Optional {
+			t.Errorf("expected %s to be computed", name)
+		}
+	}
+}
+
+func TestRegionSubscriptionResourceGet(t *testing.T) {
+	var requestedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"regionKey": "PHX", "regionName": "us-phoenix-1", "status": "READY", "isHomeRegion": true},
+			{"regionKey": "IAD", "regionName": "us-ashburn-1", "status": "IN_PROGRESS", "isHomeRegion": false}]`))
+	}))
+	defer server.Close()
+	client := &oci_identity.IdentityClient{BaseClient: newTestBaseClient(server)}
+
+	// The region key of the configuration is matched regardless of its case
+	d := RegionSubscriptionResource().TestResourceData()
+	d.SetId("iad")
+	d.Set("tenancy_id", "ocid1.tenancy.oc1..aaaa")
+	sync := &RegionSubscriptionResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+	if err := ReadResource(sync); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requestedPath != "/tenancies/ocid1.tenancy.oc1..aaaa/regionSubscriptions" {
+		t.Errorf("expected the subscriptions of the tenancy to be listed, got %s", requestedPath)
+	}
+	if d.Get("region_name").(string) != "us-ashburn-1" || d.Get("state").(string) != "IN_PROGRESS" || d.Get("is_home_region").(bool) {
+		t.Errorf("expected the IAD subscription, got %v %v %v", d.Get("region_name"), d.Get("state"), d.Get("is_home_region"))
+	}
+
+	// Import
+	d = RegionSubscriptionResource().TestResourceData()
+	d.SetId("tenancies/ocid1.tenancy.oc1..bbbb/regionSubscriptions/PHX")
+	sync = &RegionSubscriptionResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+	if err := ReadResource(sync); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requestedPath != "/tenancies/ocid1.tenancy.oc1..bbbb/regionSubscriptions" {
+		t.Errorf("expected the subscriptions of the imported tenancy to be listed, got %s", requestedPath)
+	}
+	if d.Id() != "PHX" || d.Get("tenancy_id").(string) != "ocid1.tenancy.oc1..bbbb" || !d.Get("is_home_region").(bool) {
+		t.Errorf("expected the imported PHX subscription, got %s in %v", d.Id(), d.Get("tenancy_id"))
+	}
+
+	d = RegionSubscriptionResource().TestResourceData()
+	d.SetId("FRA")
+	d.Set("tenancy_id", "ocid1.tenancy.oc1..aaaa")
+	sync = &RegionSubscriptionResourceCrud{BaseCrud: BaseCrud{D: d}, Client: client, DisableNotFoundRetries: true}
+	if err := sync.Get(); err == nil || !isMissingResourceError(err) {
+		t.Errorf("expected a missing resource error for a region the tenancy is not subscribed to, got %v", err)
+	}
+	if err := ReadResource(sync); err != nil || d.Id() != "" {
+		t.Errorf("expected a region the tenancy is not subscribed to to be removed from the state, got %v and the id %q", err, d.Id())
+	}
+}
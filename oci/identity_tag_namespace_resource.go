@@ -140,7 +140,7 @@ func (s *TagNamespaceResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	contextToUse := context.Background()
 	response, err := s.Client.CreateTagNamespace(contextToUse, request)
@@ -192,7 +192,7 @@ func (s *TagNamespaceResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.TagNamespaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetTagNamespace(context.Background(), request)
 	if err != nil {
@@ -231,7 +231,7 @@ func (s *TagNamespaceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.TagNamespaceId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateTagNamespace(context.Background(), request)
 	if err != nil {
@@ -5,7 +5,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 
@@ -149,7 +148,7 @@ func (s *TagResourceCrud) Create() error {
 		request.TagNamespaceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	contextToUse := context.Background()
 	response, err := s.Client.CreateTag(contextToUse, request)
@@ -215,7 +214,7 @@ func (s *TagResourceCrud) Get() error {
 		request.TagNamespaceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetTag(context.Background(), request)
 	if err != nil {
@@ -274,7 +273,7 @@ func (s *TagResourceCrud) Update() error {
 		request.TagNamespaceId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateTag(context.Background(), request)
 	if err != nil {
@@ -320,14 +319,11 @@ func (s *TagResourceCrud) SetData() error {
 }
 
 func parseTagCompositeId(compositeId string) (tagName string, tagNamespaceId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("tagNamespaces/.*/tags/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "tagNamespaces", "tags")
+	if err != nil {
 		return
 	}
-	tagNamespaceId = parts[1]
-	tagName = parts[3]
+	tagNamespaceId, tagName = ids[0], ids[1]
 
 	return
 }
@@ -48,6 +48,7 @@ func readSingularTenancy(d *schema.ResourceData, m interface{}) error {
 	sync := &TenancyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
+	sync.Cache = m.(*OracleClients).lookupCache
 
 	return ReadResource(sync)
 }
@@ -55,6 +56,7 @@ func readSingularTenancy(d *schema.ResourceData, m interface{}) error {
 type TenancyDataSourceCrud struct {
 	D      *schema.ResourceData
 	Client *oci_identity.IdentityClient
+	Cache  *lookupCache
 	Res    *oci_identity.GetTenancyResponse
 }
 
@@ -72,12 +74,15 @@ func (s *TenancyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetTenancy(context.Background(), request)
+	response, err := s.Cache.get("tenancy/"+*request.TenancyId, func() (interface{}, error) {
+		return s.Client.GetTenancy(context.Background(), request)
+	})
 	if err != nil {
 		return err
 	}
 
-	s.Res = &response
+	res := response.(oci_identity.GetTenancyResponse)
+	s.Res = &res
 	return nil
 }
 
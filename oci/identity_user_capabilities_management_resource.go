@@ -4,11 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -107,17 +103,15 @@ type UserCapabilitiesManagementResourceCrud struct {
 }
 
 func getUserCapabilitiesCompositeId(userId string) string {
-	return "capabilities" + "/" + userId
+	return buildCompositeId("capabilities", userId)
 }
 
 func parseUserCapabilitiesCompositeId(compositeId string) (userId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("capabilities/.*", compositeId)
-	if !match || len(parts) != 2 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "capabilities")
+	if err != nil {
 		return
 	}
-	userId, _ = url.PathUnescape(parts[1])
+	userId = ids[0]
 
 	return
 }
@@ -178,7 +172,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s with err %v", s.D.Id(), err)
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetUser(context.Background(), request)
 	if err != nil {
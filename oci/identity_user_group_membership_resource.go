@@ -132,7 +132,7 @@ func (s *UserGroupMembershipResourceCrud) Create() error {
 		request.UserId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.AddUserToGroup(context.Background(), request)
 	if err != nil {
@@ -149,7 +149,7 @@ func (s *UserGroupMembershipResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.UserGroupMembershipId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetUserGroupMembership(context.Background(), request)
 	if err != nil {
@@ -166,7 +166,7 @@ func (s *UserGroupMembershipResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserGroupMembershipId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.RemoveUserFromGroup(context.Background(), request)
 	return err
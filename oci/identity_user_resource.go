@@ -240,7 +240,7 @@ func (s *UserResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.CreateUser(context.Background(), request)
 	if err != nil {
@@ -257,7 +257,7 @@ func (s *UserResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.GetUser(context.Background(), request)
 	if err != nil {
@@ -291,7 +291,7 @@ func (s *UserResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateUser(context.Background(), request)
 	if err != nil {
@@ -308,7 +308,7 @@ func (s *UserResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteUser(context.Background(), request)
 	return err
@@ -98,7 +98,7 @@ type EncryptedDataResourceCrud struct {
 }
 
 func (s *EncryptedDataResourceCrud) ID() string {
-	return string(hashcode.String(*s.Res.Ciphertext))
+	return string(rune(hashcode.String(*s.Res.Ciphertext)))
 }
 
 func (s *EncryptedDataResourceCrud) Create() error {
@@ -118,7 +118,7 @@ func (s *EncryptedDataResourceCrud) Create() error {
 		request.Plaintext = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.Encrypt(context.Background(), request)
 	if err != nil {
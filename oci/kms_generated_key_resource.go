@@ -119,7 +119,7 @@ type GeneratedKeyResourceCrud struct {
 }
 
 func (s *GeneratedKeyResourceCrud) ID() string {
-	return string(hashcode.String(*s.Res.Ciphertext))
+	return string(rune(hashcode.String(*s.Res.Ciphertext)))
 }
 
 func (s *GeneratedKeyResourceCrud) Create() error {
@@ -150,7 +150,7 @@ func (s *GeneratedKeyResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.GenerateDataEncryptionKey(context.Background(), request)
 	if err != nil {
@@ -244,7 +244,7 @@ func (s *KeyResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.CreateKey(context.Background(), request)
 	if err != nil {
@@ -261,7 +261,7 @@ func (s *KeyResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.KeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.GetKey(context.Background(), request)
 	if err != nil {
@@ -283,7 +283,7 @@ func (s *KeyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.KeyId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.UpdateKey(context.Background(), request)
 	if err != nil {
@@ -300,7 +300,7 @@ func (s *KeyResourceCrud) Update() error {
 			tmpId := s.D.Id()
 			activationRequest.KeyId = &tmpId
 
-			activationRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+			activationRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 			activationResponse, err := s.Client.EnableKey(context.Background(), activationRequest)
 			if err != nil {
 				return err
@@ -311,7 +311,7 @@ func (s *KeyResourceCrud) Update() error {
 			tmpId := s.D.Id()
 			deactivationRequest.KeyId = &tmpId
 
-			deactivationRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+			deactivationRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 			deactivationResponse, err := s.Client.DisableKey(context.Background(), deactivationRequest)
 			if err != nil {
 				return err
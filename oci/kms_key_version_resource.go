@@ -5,12 +5,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"log"
-	"net/url"
 	"regexp"
 
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
@@ -128,7 +126,7 @@ func (s *KeyVersionResourceCrud) Create() error {
 		request.KeyId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.CreateKeyVersion(context.Background(), request)
 	if err != nil {
@@ -151,7 +149,7 @@ func (s *KeyVersionResourceCrud) Get() error {
 		return err
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.GetKeyVersion(context.Background(), request)
 	if err != nil {
@@ -192,21 +190,15 @@ func (s *KeyVersionResourceCrud) SetData() error {
 }
 
 func getKeyVersionCompositeId(keyId string, keyVersionId string) string {
-	keyId = url.PathEscape(keyId)
-	keyVersionId = url.PathEscape(keyVersionId)
-	compositeId := "keys/" + keyId + "/keyVersions/" + keyVersionId
-	return compositeId
+	return buildCompositeId("keys", keyId, "keyVersions", keyVersionId)
 }
 
 func parseKeyVersionCompositeId(compositeId string) (keyId string, keyVersionId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("keys/.*/keyVersions/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "keys", "keyVersions")
+	if err != nil {
 		return
 	}
-	keyId, _ = url.PathUnescape(parts[1])
-	keyVersionId, _ = url.PathUnescape(parts[3])
+	keyId, keyVersionId = ids[0], ids[1]
 
 	return
 }
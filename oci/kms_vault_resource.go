@@ -150,7 +150,7 @@ func (s *VaultResourceCrud) Create() error {
 		request.VaultType = oci_kms.CreateVaultDetailsVaultTypeEnum(vaultType.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.CreateVault(context.Background(), request)
 	if err != nil {
@@ -167,7 +167,7 @@ func (s *VaultResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.VaultId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.GetVault(context.Background(), request)
 	if err != nil {
@@ -189,7 +189,7 @@ func (s *VaultResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VaultId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.UpdateVault(context.Background(), request)
 	if err != nil {
@@ -206,7 +206,7 @@ func (s *VaultResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VaultId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	_, err := s.Client.ScheduleVaultDeletion(context.Background(), request)
 	return err
@@ -4,10 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -151,31 +148,19 @@ func (s *BackendResourceCrud) ID() string {
 }
 
 func (s *BackendResourceCrud) CreatedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *BackendResourceCrud) CreatedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *BackendResourceCrud) DeletedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *BackendResourceCrud) DeletedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *BackendResourceCrud) Create() error {
@@ -221,23 +206,14 @@ func (s *BackendResourceCrud) Create() error {
 		request.Weight = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.CreateBackend(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -245,7 +221,7 @@ func (s *BackendResourceCrud) Create() error {
 }
 
 func (s *BackendResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -278,7 +254,7 @@ func (s *BackendResourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.GetBackend(context.Background(), request)
 	if err != nil {
@@ -327,23 +303,14 @@ func (s *BackendResourceCrud) Update() error {
 		request.Weight = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateBackend(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -369,20 +336,14 @@ func (s *BackendResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteBackend(context.Background(), request)
-
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -435,23 +396,15 @@ func (s *BackendResourceCrud) SetData() error {
 }
 
 func getBackendCompositeId(backendName string, backendsetName string, loadBalancerId string) string {
-	backendName = url.PathEscape(backendName)
-	backendsetName = url.PathEscape(backendsetName)
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	compositeId := "loadBalancers/" + loadBalancerId + "/backendSets/" + backendsetName + "/backends/" + backendName
-	return compositeId
+	return buildCompositeId("loadBalancers", loadBalancerId, "backendSets", backendsetName, "backends", backendName)
 }
 
 func parseBackendCompositeId(compositeId string) (backendName string, backendsetName string, loadBalancerId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/backendSets/.*/backends/.*", compositeId)
-	if !match || len(parts) != 6 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "loadBalancers", "backendSets", "backends")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	backendsetName, _ = url.PathUnescape(parts[3])
-	backendName, _ = url.PathUnescape(parts[5])
+	loadBalancerId, backendsetName, backendName = ids[0], ids[1], ids[2]
 
 	return
 }
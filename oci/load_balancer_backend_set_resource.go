@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strings"
 	"sync"
 
@@ -272,31 +270,19 @@ func (s *BackendSetResourceCrud) ID() string {
 }
 
 func (s *BackendSetResourceCrud) CreatedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *BackendSetResourceCrud) CreatedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *BackendSetResourceCrud) DeletedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *BackendSetResourceCrud) DeletedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *BackendSetResourceCrud) Create() error {
@@ -350,23 +336,14 @@ func (s *BackendSetResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.CreateBackendSet(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -374,7 +351,7 @@ func (s *BackendSetResourceCrud) Create() error {
 }
 
 func (s *BackendSetResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -403,7 +380,7 @@ func (s *BackendSetResourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.GetBackendSet(context.Background(), request)
 	if err != nil {
@@ -491,23 +468,14 @@ func (s *BackendSetResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateBackendSet(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -528,20 +496,14 @@ func (s *BackendSetResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteBackendSet(context.Background(), request)
-
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -597,21 +559,15 @@ func (s *BackendSetResourceCrud) SetData() error {
 }
 
 func getBackendSetCompositeId(backendSetName string, loadBalancerId string) string {
-	backendSetName = url.PathEscape(backendSetName)
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	compositeId := "loadBalancers/" + loadBalancerId + "/backendSets/" + backendSetName
-	return compositeId
+	return buildCompositeId("loadBalancers", loadBalancerId, "backendSets", backendSetName)
 }
 
 func parseBackendSetCompositeId(compositeId string) (backendSetName string, loadBalancerId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/backendSets/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "loadBalancers", "backendSets")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	backendSetName, _ = url.PathUnescape(parts[3])
+	loadBalancerId, backendSetName = ids[0], ids[1]
 
 	return
 }
@@ -113,31 +113,19 @@ func (s *CertificateResourceCrud) ID() string {
 }
 
 func (s *CertificateResourceCrud) CreatedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *CertificateResourceCrud) CreatedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *CertificateResourceCrud) DeletedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *CertificateResourceCrud) DeletedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *CertificateResourceCrud) Create() error {
@@ -173,23 +161,14 @@ func (s *CertificateResourceCrud) Create() error {
 		request.PublicCertificate = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.CreateCertificate(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -197,7 +176,7 @@ func (s *CertificateResourceCrud) Create() error {
 }
 
 func (s *CertificateResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -211,7 +190,7 @@ func (s *CertificateResourceCrud) Get() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.ListCertificates(context.Background(), request)
 	if err != nil {
@@ -242,20 +221,14 @@ func (s *CertificateResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteCertificate(context.Background(), request)
-
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
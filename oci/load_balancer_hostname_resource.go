@@ -4,10 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -107,31 +104,19 @@ func (s *HostnameResourceCrud) ID() string {
 }
 
 func (s *HostnameResourceCrud) CreatedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *HostnameResourceCrud) CreatedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *HostnameResourceCrud) DeletedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *HostnameResourceCrud) DeletedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *HostnameResourceCrud) Create() error {
@@ -152,23 +137,14 @@ func (s *HostnameResourceCrud) Create() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.CreateHostname(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -176,7 +152,7 @@ func (s *HostnameResourceCrud) Create() error {
 }
 
 func (s *HostnameResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -205,7 +181,7 @@ func (s *HostnameResourceCrud) Get() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.GetHostname(context.Background(), request)
 	if err != nil {
@@ -234,23 +210,14 @@ func (s *HostnameResourceCrud) Update() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateHostname(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -271,20 +238,14 @@ func (s *HostnameResourceCrud) Delete() error {
 		request.Name = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteHostname(context.Background(), request)
-
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -316,21 +277,15 @@ func (s *HostnameResourceCrud) SetData() error {
 }
 
 func getHostnameCompositeId(loadBalancerId string, name string) string {
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	name = url.PathEscape(name)
-	compositeId := "loadBalancers/" + loadBalancerId + "/hostnames/" + name
-	return compositeId
+	return buildCompositeId("loadBalancers", loadBalancerId, "hostnames", name)
 }
 
 func parseHostnameCompositeId(compositeId string) (loadBalancerId string, name string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/hostnames/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "loadBalancers", "hostnames")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	name, _ = url.PathUnescape(parts[3])
+	loadBalancerId, name = ids[0], ids[1]
 
 	return
 }
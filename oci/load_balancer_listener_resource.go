@@ -6,8 +6,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 
@@ -187,31 +185,19 @@ func (s *ListenerResourceCrud) ID() string {
 }
 
 func (s *ListenerResourceCrud) CreatedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *ListenerResourceCrud) CreatedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *ListenerResourceCrud) DeletedPending() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-		string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-	}
+	return loadBalancerWorkRequestPendingStates
 }
 
 func (s *ListenerResourceCrud) DeletedTarget() []string {
-	return []string{
-		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-	}
+	return loadBalancerWorkRequestTargetStates
 }
 
 func (s *ListenerResourceCrud) Create() error {
@@ -293,23 +279,14 @@ func (s *ListenerResourceCrud) Create() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.CreateListener(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -318,7 +295,7 @@ func (s *ListenerResourceCrud) Create() error {
 
 func (s *ListenerResourceCrud) Get() (e error) {
 	// key: {workRequestID} || {loadBalancerID,name}
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -346,7 +323,7 @@ func (s *ListenerResourceCrud) Get() (e error) {
 func (s *ListenerResourceCrud) GetListener(loadBalancerID, name string) (*oci_load_balancer.Listener, error) {
 	request := oci_load_balancer.GetLoadBalancerRequest{}
 	request.LoadBalancerId = &loadBalancerID
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.GetLoadBalancer(context.Background(), request)
 	if err != nil {
@@ -442,23 +419,14 @@ func (s *ListenerResourceCrud) Update() error {
 		}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateListener(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -479,20 +447,14 @@ func (s *ListenerResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteListener(context.Background(), request)
-
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -548,21 +510,15 @@ func (s *ListenerResourceCrud) SetData() error {
 }
 
 func getListenerCompositeId(listenerName string, loadBalancerId string) string {
-	listenerName = url.PathEscape(listenerName)
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	compositeId := "loadBalancers/" + loadBalancerId + "/listeners/" + listenerName
-	return compositeId
+	return buildCompositeId("loadBalancers", loadBalancerId, "listeners", listenerName)
 }
 
 func parseListenerCompositeId(compositeId string) (listenerName string, loadBalancerId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/listeners/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	ids, err := parseCompositeId(compositeId, "loadBalancers", "listeners")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	listenerName, _ = url.PathUnescape(parts[3])
+	loadBalancerId, listenerName = ids[0], ids[1]
 
 	return
 }
@@ -73,6 +73,18 @@ func (s *LoadBalancerProtocolsDataSourceCrud) Get() error {
 	}
 
 	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListProtocols(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
 	return nil
 }
 
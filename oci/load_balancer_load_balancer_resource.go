@@ -4,6 +4,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -41,11 +43,125 @@ func LoadBalancerResource() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateOcidResourceType("subnet"),
 				},
 			},
 
 			// Optional
+			"backend_set": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: createOnlyDiffSuppressFunction,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"health_checker": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+									"protocol": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									// Optional
+									"interval_ms": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  30000,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  0,
+									},
+									"response_body_regex": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"retries": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  3,
+									},
+									"return_code": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"timeout_in_millis": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  3000,
+									},
+									"url_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									// Computed
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+						"backend": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+									"ip_address": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+
+									// Optional
+									"backup": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"drain": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"offline": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"weight": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									// Computed
+								},
+							},
+						},
+
+						// Computed
+					},
+				},
+			},
 			"defined_tags": {
 				Type:             schema.TypeMap,
 				Optional:         true,
@@ -65,6 +181,58 @@ func LoadBalancerResource() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"listener": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: createOnlyDiffSuppressFunction,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"default_backend_set_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+						"connection_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+									"idle_timeout_in_seconds": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateFunc:     validateInt64TypeString,
+										DiffSuppressFunc: int64StringDiffSuppressFunction,
+									},
+
+									// Optional
+
+									// Computed
+								},
+							},
+						},
+
+						// Computed
+					},
+				},
+			},
 
 			// Computed
 			"ip_address_details": {
@@ -154,7 +322,7 @@ func (s *LoadBalancerResourceCrud) ID() string {
 	if id != nil {
 		return *id
 	}
-	if workSuccess {
+	if workSuccess && s.WorkRequest.LoadBalancerId != nil {
 		return *s.WorkRequest.LoadBalancerId
 	}
 	return ""
@@ -194,6 +362,21 @@ func (s *LoadBalancerResourceCrud) DeletedTarget() []string {
 func (s *LoadBalancerResourceCrud) Create() error {
 	request := oci_load_balancer.CreateLoadBalancerRequest{}
 
+	if backendSet, ok := s.D.GetOkExists("backend_set"); ok {
+		interfaces := backendSet.([]interface{})
+		tmp := make(map[string]oci_load_balancer.BackendSetDetails, len(interfaces))
+		for i := range interfaces {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "backend_set", i)
+			name := s.D.Get(fmt.Sprintf(fieldKeyFormat, "name")).(string)
+			converted, err := s.mapToBackendSetDetails(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			tmp[name] = converted
+		}
+		request.BackendSets = tmp
+	}
+
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
 		tmp := compartmentId.(string)
 		request.CompartmentId = &tmp
@@ -221,6 +404,21 @@ func (s *LoadBalancerResourceCrud) Create() error {
 		request.IsPrivate = &tmp
 	}
 
+	if listener, ok := s.D.GetOkExists("listener"); ok {
+		interfaces := listener.([]interface{})
+		tmp := make(map[string]oci_load_balancer.ListenerDetails, len(interfaces))
+		for i := range interfaces {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "listener", i)
+			name := s.D.Get(fmt.Sprintf(fieldKeyFormat, "name")).(string)
+			converted, err := s.mapToListenerDetails(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			tmp[name] = converted
+		}
+		request.Listeners = tmp
+	}
+
 	if shape, ok := s.D.GetOkExists("shape"); ok {
 		tmp := shape.(string)
 		request.ShapeName = &tmp
@@ -238,31 +436,59 @@ func (s *LoadBalancerResourceCrud) Create() error {
 		request.SubnetIds = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.CreateLoadBalancer(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
+	return nil
+}
+
+// IsCreateFailureRetryable returns true when the create work request failed with an internal error or for lack of
+// capacity
+func (s *LoadBalancerResourceCrud) IsCreateFailureRetryable() bool {
+	if s.WorkRequest == nil || s.WorkRequest.LifecycleState != oci_load_balancer.WorkRequestLifecycleStateFailed {
+		return false
 	}
+	for _, errorDetail := range s.WorkRequest.ErrorDetails {
+		if errorDetail.ErrorCode == oci_load_balancer.WorkRequestErrorErrorCodeInternalError {
+			return true
+		}
+	}
+	return s.WorkRequest.Message != nil && isTransientFailureMessage(*s.WorkRequest.Message)
+}
+
+// CleanUpFailedCreate deletes the load balancer in the FAILED state that the create work request may have left behind
+func (s *LoadBalancerResourceCrud) CleanUpFailedCreate() error {
+	if s.WorkRequest != nil && s.WorkRequest.LoadBalancerId != nil {
+		s.D.SetId(*s.WorkRequest.LoadBalancerId)
+		if err := s.Delete(); err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	s.Res = nil
+	s.WorkRequest = nil
+	s.D.SetId("")
 	return nil
 }
 
 func (s *LoadBalancerResourceCrud) Get() error {
-	id, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	// A create that did not finish, e.g. because Terraform was interrupted, leaves the id of its work request in the state
+	if s.WorkRequest == nil && strings.HasPrefix(s.D.Id(), "ocid1.loadbalancerworkrequest.") {
+		stillWorking, err := s.setIdFromWorkRequest(s.D.Id())
+		if err != nil || stillWorking {
+			return err
+		}
+	}
+
+	id, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -270,8 +496,14 @@ func (s *LoadBalancerResourceCrud) Get() error {
 		return nil
 	}
 	if id == "" && s.WorkRequest != nil {
-		id = *s.WorkRequest.LoadBalancerId
-		s.D.SetId(id)
+		if s.WorkRequest.LoadBalancerId == nil {
+			return fmt.Errorf("the work request did not return the id of the load balancer")
+		}
+		s.D.SetId(*s.WorkRequest.LoadBalancerId)
+	}
+
+	if !strings.HasPrefix(s.D.Id(), "ocid1.loadbalancer.") {
+		return fmt.Errorf("%q is not the OCID of a load balancer", s.D.Id())
 	}
 
 	request := oci_load_balancer.GetLoadBalancerRequest{}
@@ -279,7 +511,7 @@ func (s *LoadBalancerResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.LoadBalancerId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.GetLoadBalancer(context.Background(), request)
 	if err != nil {
@@ -290,6 +522,31 @@ func (s *LoadBalancerResourceCrud) Get() error {
 	return nil
 }
 
+// setIdFromWorkRequest replaces the id of a create work request with the id of the load balancer it created. The work
+// request is kept while it is still running, so that the next refresh tries again.
+func (s *LoadBalancerResourceCrud) setIdFromWorkRequest(workRequestId string) (stillWorking bool, err error) {
+	request := oci_load_balancer.GetWorkRequestRequest{}
+	request.WorkRequestId = &workRequestId
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+
+	response, err := s.Client.GetWorkRequest(context.Background(), request)
+	if err != nil {
+		return false, err
+	}
+
+	switch response.LifecycleState {
+	case oci_load_balancer.WorkRequestLifecycleStateAccepted, oci_load_balancer.WorkRequestLifecycleStateInProgress:
+		return true, nil
+	}
+	if response.LoadBalancerId == nil {
+		return false, fmt.Errorf("work request %s did not return the id of the load balancer", workRequestId)
+	}
+
+	// A load balancer that failed to be created may still exist, reading it removes it from the state if it does not
+	s.D.SetId(*response.LoadBalancerId)
+	return false, nil
+}
+
 func (s *LoadBalancerResourceCrud) Update() error {
 	request := oci_load_balancer.UpdateLoadBalancerRequest{}
 
@@ -313,23 +570,14 @@ func (s *LoadBalancerResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.LoadBalancerId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateLoadBalancer(context.Background(), request)
 	if err != nil {
 		return err
 	}
 
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-	if err != nil {
-		return err
-	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -343,20 +591,14 @@ func (s *LoadBalancerResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LoadBalancerId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteLoadBalancer(context.Background(), request)
-
-	workReqID := response.OpcWorkRequestId
-	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-	getWorkRequestRequest.WorkRequestId = workReqID
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
-	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+
+	s.WorkRequest, err = LoadBalancerWaitForWorkRequestId(s.Client, s.D, response.OpcWorkRequestId, s.getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -427,3 +669,85 @@ func IpAddressToMap(obj oci_load_balancer.IpAddress) map[string]interface{} {
 
 	return result
 }
+
+// createOnlyDiffSuppressFunction suppresses every diff of the inline backend sets and listeners once the load balancer
+// exists, including the ones from adding them to an imported load balancer. A load balancer that is replaced is diffed
+// again without an id, so the new one is created with them.
+func createOnlyDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// The backend sets and listeners defined inline are created along with the load balancer in a single work request,
+// instead of one work request each. They are only used to create the load balancer: changes to them afterwards are
+// suppressed by createOnlyDiffSuppressFunction and they are not read back, so they are managed through the
+// sub-resources once the load balancer exists.
+func (s *LoadBalancerResourceCrud) mapToBackendSetDetails(fieldKeyFormat string) (oci_load_balancer.BackendSetDetails, error) {
+	result := oci_load_balancer.BackendSetDetails{}
+	backendSetCrud := &BackendSetResourceCrud{BaseCrud: s.BaseCrud}
+
+	result.Backends = []oci_load_balancer.BackendDetails{}
+	if backend, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "backend")); ok {
+		interfaces := backend.([]interface{})
+		tmp := make([]oci_load_balancer.BackendDetails, len(interfaces))
+		for i := range interfaces {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "backend"), i)
+			converted, err := backendSetCrud.mapToBackendDetails(fieldKeyFormatNextLevel)
+			if err != nil {
+				return result, err
+			}
+			tmp[i] = converted
+		}
+		result.Backends = tmp
+	}
+
+	if healthChecker, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "health_checker")); ok {
+		if tmpList := healthChecker.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "health_checker"), 0)
+			tmp, err := backendSetCrud.mapToHealthCheckerDetails(fieldKeyFormatNextLevel)
+			if err != nil {
+				return result, err
+			}
+			result.HealthChecker = &tmp
+		}
+	}
+
+	if policy, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "policy")); ok {
+		tmp := policy.(string)
+		result.Policy = &tmp
+	}
+
+	return result, nil
+}
+
+func (s *LoadBalancerResourceCrud) mapToListenerDetails(fieldKeyFormat string) (oci_load_balancer.ListenerDetails, error) {
+	result := oci_load_balancer.ListenerDetails{}
+	listenerCrud := &ListenerResourceCrud{BaseCrud: s.BaseCrud}
+
+	if connectionConfiguration, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "connection_configuration")); ok {
+		if tmpList := connectionConfiguration.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "connection_configuration"), 0)
+			tmp, err := listenerCrud.mapToConnectionConfiguration(fieldKeyFormatNextLevel)
+			if err != nil {
+				return result, err
+			}
+			result.ConnectionConfiguration = &tmp
+		}
+	}
+
+	if defaultBackendSetName, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "default_backend_set_name")); ok {
+		tmp := defaultBackendSetName.(string)
+		result.DefaultBackendSetName = &tmp
+	}
+
+	if port, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "port")); ok {
+		tmp := port.(int)
+		result.Port = &tmp
+	}
+
+	if protocol, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "protocol")); ok {
+		tmp := protocol.(string)
+		result.Protocol = &tmp
+	}
+
+	return result, nil
+}
@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/oracle/oci-go-sdk/loadbalancer"
 	"github.com/stretchr/testify/suite"
@@ -180,3 +183,128 @@ func (s *ResourceLoadBalancerLBTestSuite) TestAccResourceLoadBalancerLB_basicPub
 func TestResourceLoadBalancerLBTestSuite(t *testing.T) {
 	suite.Run(t, new(ResourceLoadBalancerLBTestSuite))
 }
+
+func TestLoadBalancerResourceCrudInlineBackendSetsAndListeners(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, LoadBalancerResource().Schema, map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaaa",
+		"display_name":   "lb",
+		"shape":          "100Mbps",
+		"subnet_ids":     []interface{}{"ocid1.subnet.oc1.phx.aaaa"},
+		"backend_set": []interface{}{map[string]interface{}{
+			"name":   "bs1",
+			"policy": "ROUND_ROBIN",
+			"health_checker": []interface{}{map[string]interface{}{
+				"protocol": "HTTP",
+				"url_path": "/",
+			}},
+			"backend": []interface{}{map[string]interface{}{
+				"ip_address": "10.0.0.2",
+				"port":       8080,
+			}},
+		}},
+		"listener": []interface{}{map[string]interface{}{
+			"name":                     "http",
+			"default_backend_set_name": "bs1",
+			"port":                     80,
+			"protocol":                 "HTTP",
+		}},
+	})
+	s := &LoadBalancerResourceCrud{}
+	s.D = d
+
+	backendSet, err := s.mapToBackendSetDetails("backend_set.0.%s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backendSet.Policy == nil || *backendSet.Policy != "ROUND_ROBIN" {
+		t.Errorf("expected the policy to be ROUND_ROBIN, got %v", backendSet.Policy)
+	}
+	if backendSet.HealthChecker == nil || *backendSet.HealthChecker.Protocol != "HTTP" || *backendSet.HealthChecker.UrlPath != "/" {
+		t.Errorf("expected an HTTP health checker on /, got %v", backendSet.HealthChecker)
+	}
+	if len(backendSet.Backends) != 1 || *backendSet.Backends[0].IpAddress != "10.0.0.2" || *backendSet.Backends[0].Port != 8080 {
+		t.Errorf("expected a single backend 10.0.0.2:8080, got %v", backendSet.Backends)
+	}
+	if backendSet.Backends[0].Weight != nil {
+		t.Errorf("expected the backend weight to be left to the service, got %v", *backendSet.Backends[0].Weight)
+	}
+
+	listener, err := s.mapToListenerDetails("listener.0.%s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *listener.DefaultBackendSetName != "bs1" || *listener.Port != 80 || *listener.Protocol != "HTTP" {
+		t.Errorf("expected an HTTP listener on port 80 for bs1, got %v", listener)
+	}
+	if listener.ConnectionConfiguration != nil {
+		t.Errorf("expected no connection configuration, got %v", listener.ConnectionConfiguration)
+	}
+}
+
+func TestLoadBalancerResourceInlineBlocksAreCreateOnly(t *testing.T) {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..aaaa",
+		"display_name":   "lb",
+		"shape":          "100Mbps",
+		"subnet_ids":     []interface{}{"ocid1.subnet.oc1.phx.aaaa"},
+		"listener": []interface{}{map[string]interface{}{
+			"name":                     "http",
+			"default_backend_set_name": "bs1",
+			"port":                     8080,
+			"protocol":                 "HTTP",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c := terraform.NewResourceConfig(raw)
+
+	diff, err := LoadBalancerResource().Diff(nil, c, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff.Attributes["listener.0.port"] == nil || diff.Attributes["listener.0.port"].New != "8080" {
+		t.Errorf("expected the listener to be part of the create diff, got %v", diff.Attributes)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "ocid1.loadbalancer.oc1.phx.aaaa",
+		Attributes: map[string]string{
+			"id":                                  "ocid1.loadbalancer.oc1.phx.aaaa",
+			"compartment_id":                      "ocid1.compartment.oc1..aaaa",
+			"display_name":                        "lb",
+			"shape":                               "100Mbps",
+			"subnet_ids.#":                        "1",
+			"subnet_ids.0":                        "ocid1.subnet.oc1.phx.aaaa",
+			"listener.#":                          "1",
+			"listener.0.name":                     "http",
+			"listener.0.default_backend_set_name": "bs1",
+			"listener.0.port":                     "80",
+			"listener.0.protocol":                 "HTTP",
+		},
+	}
+	diff, err = LoadBalancerResource().Diff(state, c, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for key := range diff.Attributes {
+		if strings.HasPrefix(key, "listener") {
+			t.Errorf("expected changes to the inline listener of an existing load balancer to be ignored, got a diff for %s", key)
+		}
+	}
+
+	for key := range state.Attributes {
+		if strings.HasPrefix(key, "listener.") {
+			delete(state.Attributes, key)
+		}
+	}
+	diff, err = LoadBalancerResource().Diff(state, c, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for key := range diff.Attributes {
+		if strings.HasPrefix(key, "listener") {
+			t.Errorf("expected adding an inline listener to an imported load balancer to be ignored, got a diff for %s", key)
+		}
+	}
+}
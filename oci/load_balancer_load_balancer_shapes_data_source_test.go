@@ -3,9 +3,12 @@
 package provider
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
 )
 
 func TestAccDatasourceLoadBalancerShapes_basic(t *testing.T) {
@@ -36,3 +39,28 @@ func TestAccDatasourceLoadBalancerShapes_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestLoadBalancerShapesDataSourceCrudGetAllPages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("opc-next-page", "page-2")
+			w.Write([]byte(`[{"name": "100Mbps"}]`))
+			return
+		}
+		w.Write([]byte(`[{"name": "400Mbps"}]`))
+	}))
+	defer server.Close()
+
+	sync := &LoadBalancerShapesDataSourceCrud{}
+	sync.D = LoadBalancerShapesDataSource().TestResourceData()
+	sync.D.Set("compartment_id", "ocid1.compartment.oc1..aaaa")
+	sync.Client = &oci_load_balancer.LoadBalancerClient{BaseClient: newTestBaseClient(server)}
+
+	if err := sync.Get(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sync.Res.Items) != 2 || *sync.Res.Items[1].Name != "400Mbps" {
+		t.Errorf("expected the shapes of both pages, got %v", sync.Res.Items)
+	}
+}
@@ -4,16 +4,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
 )
 
 func BucketResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createBucket,
 		Read:     readBucket,
@@ -41,6 +47,11 @@ func BucketResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  string(oci_object_storage.CreateBucketDetailsPublicAccessTypeNopublicaccess),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_object_storage.CreateBucketDetailsPublicAccessTypeNopublicaccess),
+					string(oci_object_storage.CreateBucketDetailsPublicAccessTypeObjectread),
+					string(oci_object_storage.CreateBucketDetailsPublicAccessTypeObjectreadwithoutlist),
+				}, false),
 			},
 			"defined_tags": {
 				Type:             schema.TypeMap,
@@ -149,6 +160,18 @@ func (s *BucketResourceCrud) ID() string {
 	return *s.Res.Namespace + "/" + *s.Res.Name
 }
 
+func parseBucketId(id string) (namespace string, name string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = fmt.Errorf("illegal bucket id %s encountered, expected <namespace>/<name>", id)
+		return
+	}
+	namespace = parts[0]
+	name = parts[1]
+
+	return
+}
+
 func (s *BucketResourceCrud) Create() error {
 	request := oci_object_storage.CreateBucketRequest{}
 
@@ -196,7 +219,7 @@ func (s *BucketResourceCrud) Create() error {
 		request.StorageTier = oci_object_storage.CreateBucketDetailsStorageTierEnum(storageTier.(string))
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.CreateBucket(context.Background(), request)
 	if err != nil {
@@ -220,8 +243,18 @@ func (s *BucketResourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
+	// On import only the ID is known
+	if request.BucketName == nil || request.NamespaceName == nil {
+		namespace, name, err := parseBucketId(s.D.Id())
+		if err != nil {
+			return err
+		}
+		request.BucketName = &name
+		request.NamespaceName = &namespace
+	}
+
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.GetBucket(context.Background(), request)
 	if err != nil {
@@ -266,7 +299,8 @@ func (s *BucketResourceCrud) Update() error {
 		request.KmsKeyId = &tmp
 	}
 
-	if metadata, ok := s.D.GetOkExists("metadata"); ok {
+	// Send an empty map when all metadata has been removed from the config, otherwise the existing metadata is kept
+	if metadata, ok := s.D.GetOkExists("metadata"); ok || s.D.HasChange("metadata") {
 		request.Metadata = resourceObjectStorageMapToMetadata(metadata.(map[string]interface{}))
 	}
 
@@ -291,7 +325,7 @@ func (s *BucketResourceCrud) Update() error {
 	//	request.Namespace = &tmp
 	//}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.UpdateBucket(context.Background(), request)
 	if err != nil {
@@ -315,7 +349,7 @@ func (s *BucketResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	_, err := s.Client.DeleteBucket(context.Background(), request)
 	return err
@@ -354,9 +388,7 @@ func (s *BucketResourceCrud) SetData() error {
 		s.D.Set("kms_key_id", *s.Res.KmsKeyId)
 	}
 
-	if s.Res.Metadata != nil {
-		s.D.Set("metadata", s.Res.Metadata)
-	}
+	s.D.Set("metadata", s.Res.Metadata)
 
 	if s.Res.Name != nil {
 		s.D.Set("name", *s.Res.Name)
@@ -236,6 +236,24 @@ func TestObjectStorageBucketResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "approximate_size"),
 				),
 			},
+			// verify removing all metadata clears it from the bucket
+			{
+				Config: config + compartmentIdVariableStr + BucketResourceDependencies +
+					generateResourceFromRepresentationMap("oci_objectstorage_bucket", "test_bucket", Optional, Update,
+						representationCopyWithRemovedProperties(bucketRepresentation, []string{"metadata"})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", "name2"),
+				),
+			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
@@ -29,6 +29,7 @@ func readSingularNamespace(d *schema.ResourceData, m interface{}) error {
 	sync := &NamespaceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
+	sync.Cache = m.(*OracleClients).lookupCache
 
 	return ReadResource(sync)
 }
@@ -36,6 +37,7 @@ func readSingularNamespace(d *schema.ResourceData, m interface{}) error {
 type NamespaceDataSourceCrud struct {
 	D      *schema.ResourceData
 	Client *oci_object_storage.ObjectStorageClient
+	Cache  *lookupCache
 	Res    *oci_object_storage.GetNamespaceResponse
 }
 
@@ -48,12 +50,16 @@ func (s *NamespaceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetNamespace(context.Background(), request)
+	// The namespace of a tenancy never changes
+	response, err := s.Cache.get("namespace", func() (interface{}, error) {
+		return s.Client.GetNamespace(context.Background(), request)
+	})
 	if err != nil {
 		return err
 	}
 
-	s.Res = &response
+	res := response.(oci_object_storage.GetNamespaceResponse)
+	s.Res = &res
 	return nil
 }
 
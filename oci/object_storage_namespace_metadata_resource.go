@@ -77,7 +77,7 @@ func (s *NamespaceMetadataResourceCrud) Get() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
 	if err != nil {
@@ -107,7 +107,7 @@ func (s *NamespaceMetadataResourceCrud) Update() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.UpdateNamespaceMetadata(context.Background(), request)
 	if err != nil {
@@ -193,7 +193,7 @@ func (s *ObjectLifecyclePolicyResourceCrud) Create() error {
 		request.Items = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
 	if err != nil {
@@ -225,7 +225,7 @@ func (s *ObjectLifecyclePolicyResourceCrud) Get() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
 	if err != nil {
@@ -266,7 +266,7 @@ func (s *ObjectLifecyclePolicyResourceCrud) Update() error {
 		request.Items = tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
 	if err != nil {
@@ -290,7 +290,7 @@ func (s *ObjectLifecyclePolicyResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	_, err := s.Client.DeleteObjectLifecyclePolicy(context.Background(), request)
 	return err
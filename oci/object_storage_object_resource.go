@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"crypto/md5"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 
@@ -259,7 +260,7 @@ func (s *ObjectResourceCrud) createMultiPartObject() error {
 	}
 
 	multipartUploadData.ObjectStorageClient = s.Client
-	multipartUploadData.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	multipartUploadData.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	s.D.Set("work_request_id", "")
 	s.D.Set("state", oci_object_storage.WorkRequestStatusInProgress)
@@ -350,7 +351,7 @@ func (s *ObjectResourceCrud) createCopyObject() error {
 		copyObjectRequest.DestinationObjectName = &tmp
 	}
 
-	copyObjectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	copyObjectRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	var workRequestId = ""
 	if state, ok := s.D.GetOkExists("state"); ok {
@@ -374,7 +375,7 @@ func (s *ObjectResourceCrud) createCopyObject() error {
 
 	getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = &workRequestId
-	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
 	if err != nil {
 		return err
@@ -448,17 +449,13 @@ func getId(namespaceName string, bucketName string, objectName string) string {
 }
 
 func parseId(id string) (namespaceName string, bucketName string, objectName string, err error) {
-	parts := strings.Split(strings.TrimPrefix(id, ObjIdPrefix), ObjIdDelim)
+	// The delimiter may be used in the object name, so everything after the bucket name is the object name
+	parts := strings.SplitN(strings.TrimPrefix(id, ObjIdPrefix), ObjIdDelim, 3)
 	if len(parts) < 3 {
 		err = fmt.Errorf("Illegal id %s encountered", id)
+		return
 	}
 	namespaceName, bucketName, objectName = parts[0], parts[1], parts[2]
-
-	// Sometimes, the delimiter is used in the object name, and we should use all of the remaining parts, rather than
-	// first only
-	if len(parts) > 3 {
-		objectName = strings.Join(parts[2:], ObjIdDelim)
-	}
 	return
 }
 
@@ -528,6 +525,11 @@ func (s *ObjectResourceCrud) createContentObject() error {
 		tmpLength := int64(len(tmp))
 		request.ContentLength = &tmpLength
 		request.PutObjectBody = ioutil.NopCloser(bytes.NewBuffer(tmp))
+
+		// Have the service verify the integrity of the uploaded content
+		contentMd5 := md5.Sum(tmp)
+		contentMd5Base64 := base64.StdEncoding.EncodeToString(contentMd5[:])
+		request.ContentMD5 = &contentMd5Base64
 	} else {
 		tmp := int64(0)
 		request.ContentLength = &tmp
@@ -548,7 +550,7 @@ func (s *ObjectResourceCrud) createContentObject() error {
 		request.ObjectName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	s.D.Set("work_request_id", "")
 	s.D.Set("state", oci_object_storage.WorkRequestStatusInProgress)
@@ -585,7 +587,7 @@ func (s *ObjectResourceCrud) getObjectHead() error {
 		return fmt.Errorf("'namespace', 'bucket', or 'object' identifiers are missing")
 	}
 
-	headObjectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	headObjectRequest.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	headObjectResponse, err := s.Client.HeadObject(context.Background(), *headObjectRequest)
 	if err != nil {
@@ -608,7 +610,7 @@ func (s *ObjectResourceCrud) updateState() (bool, error) {
 		if state == oci_object_storage.WorkRequestStatusInProgress {
 
 			if wrid, ok := s.D.GetOkExists("work_request_id"); ok {
-				retryPolicy := getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+				retryPolicy := s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 				copyTimeout := DefaultTimeout.Create
 				retryPolicy.ShouldRetryOperation = objectStorageWorkRequestShouldRetryFunc(*copyTimeout)
 
@@ -713,7 +715,7 @@ func (s *ObjectResourceCrud) getObject() error {
 
 	// TODO: May be better to use HeadObject() to retrieve status of the object. For large content, doesn't make sense
 	// to call Get() all the time
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.GetObject(context.Background(), request)
 	if err != nil {
@@ -753,7 +755,7 @@ func (s *ObjectResourceCrud) Update() error {
 		request.NewName = &tmp
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 	_, err = s.Client.RenameObject(context.Background(), request)
 	if err != nil {
 		return err
@@ -776,7 +778,7 @@ func (s *ObjectResourceCrud) Delete() error {
 	request.BucketName = &bucketName
 	request.ObjectName = &objectName
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	_, err = s.Client.DeleteObject(context.Background(), request)
 	return err
@@ -48,9 +48,10 @@ func PreauthenticatedRequestResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"time_expires": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: timestampDiffSuppressFunction,
 			},
 
 			// Optional
@@ -63,8 +64,9 @@ func PreauthenticatedRequestResource() *schema.Resource {
 
 			// Computed
 			"access_uri": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"time_created": {
 				Type:     schema.TypeString,
@@ -146,7 +148,7 @@ func (s *PreauthenticatedRequestResourceCrud) Create() error {
 		request.TimeExpires = &oci_common.SDKTime{Time: tmp}
 	}
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.CreatePreauthenticatedRequest(context.Background(), request)
 	if err != nil {
@@ -173,7 +175,7 @@ func (s *PreauthenticatedRequestResourceCrud) Get() error {
 	tmp := s.D.Id()
 	request.ParId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
 	if err != nil {
@@ -211,7 +213,7 @@ func (s *PreauthenticatedRequestResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ParId = &tmp
 
-	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+	request.RequestMetadata.RetryPolicy = s.getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	_, err := s.Client.DeletePreauthenticatedRequest(context.Background(), request)
 	return err
@@ -237,7 +239,7 @@ func (s *PreauthenticatedRequestResourceCrud) SetData() error {
 	}
 
 	if s.Res.TimeExpires != nil {
-		s.D.Set("time_expires", s.Res.TimeExpires)
+		s.D.Set("time_expires", s.Res.TimeExpires.Format(time.RFC3339Nano))
 	}
 
 	return nil
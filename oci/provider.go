@@ -10,8 +10,11 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"os/user"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
 	"time"
@@ -31,9 +34,11 @@ const (
 	authAPIKeySetting                     = "ApiKey"
 	authInstancePrincipalSetting          = "InstancePrincipal"
 	authInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
+	authSecurityTokenSetting              = "SecurityToken"
 	requestHeaderOpcOboToken              = "opc-obo-token"
 	requestHeaderOpcHostSerial            = "opc-host-serial"
-	defaultRequestTimeout                 = 0
+	requestHeaderOpcRequestId             = "opc-request-id"
+	defaultRequestTimeout                 = 0 * time.Second
 	defaultConnectionTimeout              = 10 * time.Second
 	defaultTLSHandshakeTimeout            = 5 * time.Second
 	defaultUserAgentProviderName          = "Oracle-TerraformProvider"
@@ -44,17 +49,29 @@ const (
 	oracleR1DomainNameEnv                 = "oracle_r1_domain_name" // deprecate
 	r1CertLocationEnv                     = "R1_CERT_LOCATION"      // deprecate
 
-	authAttrName                 = "auth"
-	tenancyOcidAttrName          = "tenancy_ocid"
-	userOcidAttrName             = "user_ocid"
-	fingerprintAttrName          = "fingerprint"
-	privateKeyAttrName           = "private_key"
-	privateKeyPathAttrName       = "private_key_path"
-	privateKeyPasswordAttrName   = "private_key_password"
-	regionAttrName               = "region"
-	disableAutoRetriesAttrName   = "disable_auto_retries"
-	retryDurationSecondsAttrName = "retry_duration_seconds"
-	oboTokenAttrName             = "obo_token"
+	authAttrName                      = "auth"
+	tenancyOcidAttrName               = "tenancy_ocid"
+	userOcidAttrName                  = "user_ocid"
+	fingerprintAttrName               = "fingerprint"
+	privateKeyAttrName                = "private_key"
+	privateKeyPathAttrName            = "private_key_path"
+	privateKeyPasswordAttrName        = "private_key_password"
+	regionAttrName                    = "region"
+	disableAutoRetriesAttrName        = "disable_auto_retries"
+	retryDurationSecondsAttrName      = "retry_duration_seconds"
+	maxRetriesAttrName                = "max_retries"
+	createGracePeriodAttrName         = "create_grace_period_seconds"
+	defaultTimeoutMinutesAttrName     = "default_timeout_minutes"
+	createFailureRetriesAttrName      = "create_failure_retries"
+	requestTimeoutAttrName            = "request_timeout_seconds"
+	tlsHandshakeTimeoutAttrName       = "tls_handshake_timeout_seconds"
+	proxyUrlAttrName                  = "proxy_url"
+	endpointsAttrName                 = "endpoints"
+	userAgentSuffixAttrName           = "user_agent_suffix"
+	maxConcurrentRequestsAttrName     = "max_concurrent_requests"
+	skipCredentialsValidationAttrName = "skip_credentials_validation"
+	oboTokenAttrName                  = "obo_token"
+	configFileProfileAttrName         = "config_file_profile"
 
 	tfEnvPrefix  = "TF_VAR_"
 	ociEnvPrefix = "OCI_"
@@ -90,8 +107,8 @@ func ociVarName(attrName string) string {
 
 func init() {
 	descriptions = map[string]string{
-		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authAPIKeySetting),
-		tenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
+		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authSecurityTokenSetting, authAPIKeySetting),
+		tenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s' and the tenancy is not set in the DEFAULT profile of ~/.oci/config, ignored otherwise.", authAPIKeySetting),
 		userOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		fingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		regionAttrName:      "(Required) The region for API connections (e.g. us-ashburn-1).",
@@ -104,16 +121,40 @@ func init() {
 			"Automatic retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
 		retryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
+		maxRetriesAttrName: "(Optional) The maximum number of times to retry a resource operation in response to an error.\n" +
+			"Retries also stop once the retry duration has elapsed. This value is ignored if the `disable_auto_retries` field is set to true.",
+		createGracePeriodAttrName: fmt.Sprintf("(Optional) The duration (in seconds) during which reading a resource that was just created may return 404 before the resource is treated as missing. Defaults to %d seconds.\n", int(createdNotFoundGracePeriod/time.Second)) +
+			"Some services, like identity and object storage, are eventually consistent. This value is ignored if the `disable_auto_retries` field is set to true.",
+		createFailureRetriesAttrName: "(Optional) The number of times to request the creation of a load balancer or a DB system again when it fails with an internal error or for lack of capacity. " +
+			"The resource left behind by the failed creation is deleted first. Defaults to 0. This value is ignored if the `disable_auto_retries` field is set to true.",
+		defaultTimeoutMinutesAttrName: fmt.Sprintf("(Optional) The timeouts (in minutes) of the create, update and delete operations of the resources of this provider, "+
+			"including those that set longer timeouts of their own, like instances, images and DB systems. By default most resources time out after %d minutes.\n", int(FifteenMinutes/time.Minute)) +
+			"A timeouts block in a resource still overrides them.",
+		requestTimeoutAttrName: "(Optional) The timeout (in seconds) for a single HTTP request to the service, including reading the response body.\n" +
+			"By default requests do not time out.",
+		tlsHandshakeTimeoutAttrName: fmt.Sprintf("(Optional) The timeout (in seconds) for the TLS handshake with the service. Defaults to %d seconds.", int(defaultTLSHandshakeTimeout/time.Second)),
+		proxyUrlAttrName: "(Optional) The URL of the proxy used to reach the services (e.g. http://proxy.example.com:3128).\n" +
+			"If not set, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are honored.",
+		endpointsAttrName: "(Optional) Overrides the endpoint of a service, e.g. for a dedicated realm or a local mock of the API.\n" +
+			"Each endpoint is a host name or a URL such as https://iaas.us-phoenix-1.oraclecloud.com.",
+		userAgentSuffixAttrName: "(Optional) A string appended to the User-Agent of every request, e.g. to identify the pipeline or tool running Terraform.",
+		maxConcurrentRequestsAttrName: "(Optional) The maximum number of requests that are sent to each service at the same time.\n" +
+			"Lowering it helps to stay within the request rate limits of the tenancy when applying large configurations. By default the number of requests is not limited.",
+		skipCredentialsValidationAttrName: "(Optional) Skip checking the credentials with a request to the identity service when the provider is configured.\n" +
+			"By default invalid credentials are reported once, before any resource is read or changed.",
+		configFileProfileAttrName: "(Optional) The profile name to be used from the config file, if not specified the DEFAULT profile will be used.\n" +
+			fmt.Sprintf("When set, the %s, %s, %s and private key that are not given in the provider configuration are read from this profile of ~/.oci/config or ~/.oraclebmc/config.", tenancyOcidAttrName, userOcidAttrName, fingerprintAttrName),
 	}
 }
 
 // Provider is the adapter for terraform, that gives access to all the resources
 func Provider(configfn schema.ConfigureFunc) terraform.ResourceProvider {
+	resources := resourcesMap()
 	return &schema.Provider{
 		DataSourcesMap: dataSourcesMap(),
 		Schema:         schemaMap(),
-		ResourcesMap:   resourcesMap(),
-		ConfigureFunc:  configfn,
+		ResourcesMap:   resources,
+		ConfigureFunc:  configureDefaultTimeouts(resources, configfn),
 	}
 }
 
@@ -124,7 +165,7 @@ func schemaMap() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  descriptions[authAttrName],
 			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(authAttrName), ociVarName(authAttrName)}, authAPIKeySetting),
-			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting}, true),
+			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authSecurityTokenSetting}, true),
 		},
 		tenancyOcidAttrName: {
 			Type:        schema.TypeString,
@@ -168,10 +209,11 @@ func schemaMap() map[string]*schema.Schema {
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(privateKeyPasswordAttrName), ociVarName(privateKeyPasswordAttrName)}, nil),
 		},
 		regionAttrName: {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: descriptions[regionAttrName],
-			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(regionAttrName), ociVarName(regionAttrName)}, nil),
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  descriptions[regionAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(regionAttrName), ociVarName(regionAttrName)}, nil),
+			ValidateFunc: validateRegion,
 		},
 		disableAutoRetriesAttrName: {
 			Type:        schema.TypeBool,
@@ -186,6 +228,128 @@ func schemaMap() map[string]*schema.Schema {
 			Description: descriptions[retryDurationSecondsAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(retryDurationSecondsAttrName), ociVarName(retryDurationSecondsAttrName)}, nil),
 		},
+		maxRetriesAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[maxRetriesAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(maxRetriesAttrName), ociVarName(maxRetriesAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		createGracePeriodAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[createGracePeriodAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(createGracePeriodAttrName), ociVarName(createGracePeriodAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		createFailureRetriesAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[createFailureRetriesAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(createFailureRetriesAttrName), ociVarName(createFailureRetriesAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		defaultTimeoutMinutesAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: descriptions[defaultTimeoutMinutesAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"create": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"delete": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"update": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+		requestTimeoutAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[requestTimeoutAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(requestTimeoutAttrName), ociVarName(requestTimeoutAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		tlsHandshakeTimeoutAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[tlsHandshakeTimeoutAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(tlsHandshakeTimeoutAttrName), ociVarName(tlsHandshakeTimeoutAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		proxyUrlAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[proxyUrlAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(proxyUrlAttrName), ociVarName(proxyUrlAttrName)}, nil),
+		},
+		endpointsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: descriptions[endpointsAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					// Optional
+					"core": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"database": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"identity": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"load_balancer": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"object_storage": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		userAgentSuffixAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[userAgentSuffixAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(userAgentSuffixAttrName), ociVarName(userAgentSuffixAttrName)}, nil),
+		},
+		maxConcurrentRequestsAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[maxConcurrentRequestsAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(maxConcurrentRequestsAttrName), ociVarName(maxConcurrentRequestsAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		skipCredentialsValidationAttrName: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: descriptions[skipCredentialsValidationAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(skipCredentialsValidationAttrName), ociVarName(skipCredentialsValidationAttrName)}, false),
+		},
+		configFileProfileAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[configFileProfileAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(configFileProfileAttrName), ociVarName(configFileProfileAttrName)}, nil),
+		},
 	}
 }
 
@@ -266,6 +430,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_core_virtual_circuit":                       VirtualCircuitDataSource(),
 		"oci_core_virtual_circuits":                      VirtualCircuitsDataSource(),
 		"oci_core_virtual_networks":                      VcnsDataSource(), //This is a legacy name for VCN, removing it can cause breaking changes
+		"oci_core_vcn":                                   VcnDataSource(),
 		"oci_core_vcns":                                  VcnsDataSource(),
 		"oci_core_vnic":                                  VnicDataSource(),
 		"oci_core_vnic_attachments":                      VnicAttachmentsDataSource(),
@@ -302,6 +467,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_database_db_home_patches":                   DbHomePatchesDataSource(),
 		"oci_database_db_home_patch_history_entries":     DbHomePatchHistoryEntriesDataSource(),
 		"oci_dns_records":                                RecordsDataSource(),
+		"oci_dns_rrset":                                  RrsetDataSource(),
 		"oci_dns_zones":                                  ZonesDataSource(),
 		"oci_email_senders":                              SendersDataSource(),
 		"oci_email_sender":                               SenderDataSource(),
@@ -390,6 +556,7 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_core_drg":                                            DrgResource(),
 		"oci_core_drg_attachment":                                 DrgAttachmentResource(),
 		"oci_core_image":                                          ImageResource(),
+		"oci_core_image_export":                                   ImageExportResource(),
 		"oci_core_instance":                                       InstanceResource(),
 		"oci_core_instance_console_connection":                    InstanceConsoleConnectionResource(),
 		"oci_core_instance_configuration":                         InstanceConfigurationResource(),
@@ -426,7 +593,10 @@ func resourcesMap() map[string]*schema.Resource {
 		//"oci_database_db_home":                     DbHomeResource(),
 		"oci_database_db_system":                    DbSystemResource(),
 		"oci_database_backup":                       BackupResource(),
+		"oci_database_data_guard_association":       DataGuardAssociationResource(),
+		"oci_database_db_node_power_management":     DbNodePowerManagementResource(),
 		"oci_dns_record":                            RecordResource(),
+		"oci_dns_rrset":                             RrsetResource(),
 		"oci_dns_zone":                              ZoneResource(),
 		"oci_email_sender":                          SenderResource(),
 		"oci_email_suppression":                     SuppressionResource(),
@@ -444,6 +614,7 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_identity_identity_provider":            IdentityProviderResource(),
 		"oci_identity_idp_group_mapping":            IdpGroupMappingResource(),
 		"oci_identity_policy":                       PolicyResource(),
+		"oci_identity_region_subscription":          RegionSubscriptionResource(),
 		"oci_identity_smtp_credential":              SmtpCredentialResource(),
 		"oci_identity_swift_password":               SwiftPasswordResource(),
 		"oci_identity_tag_namespace":                TagNamespaceResource(),
@@ -504,8 +675,23 @@ func getRequiredEnvSetting(s string) string {
 	return v
 }
 
+// The acceptance tests set these to record and replay the requests of the provider, see provider_http_replay_test.go.
+// They are not set in the provider binary.
+var (
+	wrapTestTransport func(transport http.RoundTripper) (http.RoundTripper, error)
+	testRequestSigner func() oci_common.HTTPRequestSigner
+)
+
+// defaultConfigProvider reads the DEFAULT profile of the SDK/CLI config files, and the TF_VAR_ environment variables
+var defaultConfigProvider = oci_common.DefaultConfigProvider
+
 func validateConfigForAPIKeyAuth(d *schema.ResourceData) error {
 	_, hasTenancyOCID := d.GetOkExists(tenancyOcidAttrName)
+	if !hasTenancyOCID {
+		// The tenancy of the key can be discovered from the DEFAULT profile of the SDK/CLI config file
+		_, err := defaultConfigProvider().TenancyOCID()
+		hasTenancyOCID = err == nil
+	}
 	_, hasUserOCID := d.GetOkExists(userOcidAttrName)
 	_, hasFingerprint := d.GetOkExists(fingerprintAttrName)
 	if !hasTenancyOCID || !hasUserOCID || !hasFingerprint {
@@ -514,6 +700,52 @@ func validateConfigForAPIKeyAuth(d *schema.ResourceData) error {
 	return nil
 }
 
+// getConfigProvidersForProfile returns providers for the given profile of the SDK and CLI config files, in the
+// same order that the SDK's DefaultConfigProvider reads them
+func getConfigProvidersForProfile(profile string, privateKeyPassword string) ([]oci_common.ConfigurationProvider, error) {
+	homeFolder := getHomeFolder()
+	var configProviders []oci_common.ConfigurationProvider
+	for _, configFile := range []string{filepath.Join(homeFolder, ".oci", "config"), filepath.Join(homeFolder, ".oraclebmc", "config")} {
+		cfg, err := oci_common.ConfigurationProviderFromFileWithProfile(configFile, profile, privateKeyPassword)
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, cfg)
+	}
+	return configProviders, nil
+}
+
+func getHomeFolder() string {
+	current, err := user.Current()
+	if err != nil {
+		return os.Getenv("HOME")
+	}
+	return current.HomeDir
+}
+
+// Region identifiers are made of a location and a number, e.g. us-phoenix-1. The SDK also accepts
+// the short codes of some regions (see oci_common.StringToRegion), which resolve to the same endpoints.
+var regionRegexp = regexp.MustCompile(`^[a-z]+(-[a-z]+)+-[0-9]+$`)
+
+func validateRegion(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if regionRegexp.MatchString(strings.ToLower(v)) {
+		return
+	}
+	switch strings.ToLower(v) {
+	case "sea", "phx", "iad", "fra", "lhr":
+		return
+	}
+
+	es = append(es, fmt.Errorf("%s must be a region identifier such as %s, got %q", k, oci_common.RegionPHX, v))
+	return
+}
+
 func checkIncompatibleAttrsForApiKeyAuth(d *schema.ResourceData) ([]string, bool) {
 	var apiKeyConfigAttributesToUnset []string
 	for _, apiKeyConfigAttribute := range apiKeyConfigAttributes {
@@ -538,11 +770,12 @@ func getCertificateFileBytes(certificateFileFullPath string) (pemRaw []byte, err
 }
 
 func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
-	clients = &OracleClients{configuration: map[string]string{}}
+	clients = &OracleClients{configuration: map[string]string{}, lookupCache: newLookupCache()}
 
 	if d.Get(disableAutoRetriesAttrName).(bool) {
 		shortRetryTime = 0
 		longRetryTime = 0
+		createdNotFoundGracePeriod = 0
 	} else if retryDurationSeconds, exists := d.GetOkExists(retryDurationSecondsAttrName); exists {
 		val := time.Duration(retryDurationSeconds.(int)) * time.Second
 		if retryDurationSeconds.(int) < 0 {
@@ -552,30 +785,82 @@ func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
 		configuredRetryDuration = &val
 	}
 
+	if maxRetries, exists := d.GetOkExists(maxRetriesAttrName); exists && !d.Get(disableAutoRetriesAttrName).(bool) {
+		val := uint(maxRetries.(int))
+		configuredMaxRetries = &val
+	}
+
+	if createGracePeriod, exists := d.GetOkExists(createGracePeriodAttrName); exists && !d.Get(disableAutoRetriesAttrName).(bool) {
+		createdNotFoundGracePeriod = time.Duration(createGracePeriod.(int)) * time.Second
+	}
+
+	if createFailureRetries, exists := d.GetOkExists(createFailureRetriesAttrName); exists && !d.Get(disableAutoRetriesAttrName).(bool) {
+		configuredCreateFailureRetries = uint(createFailureRetries.(int))
+	}
+
 	auth := strings.ToLower(d.Get(authAttrName).(string))
 	clients.(*OracleClients).configuration[authAttrName] = auth
 
 	userAgentProviderName := getEnvSettingWithDefault(userAgentProviderNameEnv, defaultUserAgentProviderName)
 	userAgent := fmt.Sprintf(userAgentFormatter, oci_common.Version(), runtime.Version(), runtime.GOOS, runtime.GOARCH, terraform.VersionString(), userAgentProviderName, Version)
+	if userAgentSuffix, ok := d.GetOkExists(userAgentSuffixAttrName); ok && userAgentSuffix.(string) != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, userAgentSuffix)
+	}
+
+	requestTimeout := defaultRequestTimeout
+	if timeoutSeconds, exists := d.GetOkExists(requestTimeoutAttrName); exists {
+		requestTimeout = time.Duration(timeoutSeconds.(int)) * time.Second
+	}
+
+	tlsHandshakeTimeout := defaultTLSHandshakeTimeout
+	if timeoutSeconds, exists := d.GetOkExists(tlsHandshakeTimeoutAttrName); exists {
+		tlsHandshakeTimeout = time.Duration(timeoutSeconds.(int)) * time.Second
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if proxyUrl, exists := d.GetOkExists(proxyUrlAttrName); exists && proxyUrl.(string) != "" {
+		parsedProxyUrl, err := url.Parse(proxyUrl.(string))
+		if err != nil || parsedProxyUrl.Scheme == "" || parsedProxyUrl.Host == "" {
+			return nil, fmt.Errorf("%s '%s' is not a valid URL", proxyUrlAttrName, proxyUrl)
+		}
+		proxy = http.ProxyURL(parsedProxyUrl)
+	}
+
+	var transport http.RoundTripper = loggingTransport{&http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: defaultConnectionTimeout,
+		}).DialContext,
+		TLSHandshakeTimeout: tlsHandshakeTimeout,
+		TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12},
+		Proxy:               proxy,
+	}}
+
+	if maxConcurrentRequests, exists := d.GetOkExists(maxConcurrentRequestsAttrName); exists {
+		transport = newConcurrencyLimitingTransport(transport, maxConcurrentRequests.(int))
+	}
+
+	if wrapTestTransport != nil {
+		testTransport, err := wrapTestTransport(transport)
+		if err != nil {
+			return nil, err
+		}
+		transport = testTransport
+	}
 
 	httpClient := &http.Client{
-		Timeout: defaultRequestTimeout,
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout: defaultConnectionTimeout,
-			}).DialContext,
-			TLSHandshakeTimeout: defaultTLSHandshakeTimeout,
-			TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12},
-			Proxy:               http.ProxyFromEnvironment,
-		},
+		Timeout:   requestTimeout,
+		Transport: transport,
 	}
 
 	var configProviders []oci_common.ConfigurationProvider
 
 	switch auth {
 	case strings.ToLower(authAPIKeySetting):
-		if err := validateConfigForAPIKeyAuth(d); err != nil {
-			return nil, err
+		// Credentials missing from the provider configuration are looked up in the profile instead
+		if _, hasProfile := d.GetOkExists(configFileProfileAttrName); !hasProfile {
+			if err := validateConfigForAPIKeyAuth(d); err != nil {
+				return nil, err
+			}
 		}
 	case strings.ToLower(authInstancePrincipalSetting):
 		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
@@ -643,16 +928,34 @@ The values for the %v are provided now.`, ociVarName(authAttrName), authInstance
 			return nil, err
 		}
 		configProviders = append(configProviders, cfg)
+	case strings.ToLower(authSecurityTokenSetting):
+		profile, ok := d.GetOkExists(configFileProfileAttrName)
+		if !ok || profile.(string) == "" {
+			return nil, fmt.Errorf("when auth is set to '%s', %s must be set to the profile created by 'oci session authenticate'", authSecurityTokenSetting, configFileProfileAttrName)
+		}
+		cfg, err := getSecurityTokenConfigProvider(profile.(string), d.Get(privateKeyPasswordAttrName).(string))
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, cfg)
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting)
+		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authSecurityTokenSetting)
 	}
 
 	configProviders = append(configProviders, ResourceDataConfigProvider{d})
 
+	if profile, ok := d.GetOkExists(configFileProfileAttrName); ok && profile.(string) != "" {
+		profileConfigProviders, err := getConfigProvidersForProfile(profile.(string), d.Get(privateKeyPasswordAttrName).(string))
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, profileConfigProviders...)
+	}
+
 	// TODO: DefaultConfigProvider will return us a composingConfigurationProvider that reads from SDK config files,
 	// and then from the environment variables ("TF_VAR" prefix). References to "TF_VAR" prefix should be removed from
 	// the SDK, since it's Terraform specific. When that happens, we need to update this to pass in the right prefix.
-	configProviders = append(configProviders, oci_common.DefaultConfigProvider())
+	configProviders = append(configProviders, defaultConfigProvider())
 
 	officialSdkConfigProvider, err := oci_common.ComposingConfigurationProvider(configProviders)
 	if err != nil {
@@ -664,6 +967,18 @@ The values for the %v are provided now.`, ociVarName(authAttrName), authInstance
 		return nil, err
 	}
 
+	if endpoints, ok := d.GetOkExists(endpointsAttrName); ok {
+		if tmpList := endpoints.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+			setServiceEndpoints(clients.(*OracleClients), tmpList[0].(map[string]interface{}))
+		}
+	}
+
+	if !d.Get(skipCredentialsValidationAttrName).(bool) {
+		if err := validateCredentials(clients.(*OracleClients)); err != nil {
+			return nil, err
+		}
+	}
+
 	return clients, nil
 }
 
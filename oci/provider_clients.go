@@ -3,13 +3,16 @@
 package provider
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	oci_audit "github.com/oracle/oci-go-sdk/audit"
 	oci_containerengine "github.com/oracle/oci-go-sdk/containerengine"
@@ -115,6 +118,12 @@ func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_commo
 		oboTokenProvider = oboTokenProviderFromEnv{}
 	}
 
+	if testRequestSigner != nil {
+		if signer := testRequestSigner(); signer != nil {
+			requestSigner = signer
+		}
+	}
+
 	configureClient = func(client *oci_common.BaseClient) error {
 		client.HTTPClient = httpClient
 		client.UserAgent = userAgent
@@ -164,7 +173,7 @@ func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_commo
 			// install the certificates in the client
 			if h, ok := client.HTTPClient.(*http.Client); ok {
 				tr := &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}
-				h.Transport = tr
+				h.Transport = loggingTransport{tr}
 			} else {
 				return fmt.Errorf("the client dispatcher is not of http.Client type. can not patch the tls config")
 			}
@@ -262,6 +271,65 @@ func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_commo
 	return
 }
 
+const credentialsValidationTimeout = 30 * time.Second
+
+// validateCredentials makes a cheap authenticated request so that rejected credentials are reported once, instead of
+// as a 401 from every resource. Any other failure, e.g. a missing permission on the tenancy, is left to the resources.
+func validateCredentials(clients *OracleClients) error {
+	tenancyId, err := (*clients.identityClient.ConfigurationProvider()).TenancyOCID()
+	if err != nil {
+		log.Printf("[DEBUG] unable to validate the credentials, the tenancy is unknown: %v", err)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), credentialsValidationTimeout)
+	defer cancel()
+
+	request := oci_identity.GetTenancyRequest{}
+	request.TenancyId = &tenancyId
+
+	// The tenancy is cached so that an oci_identity_tenancy data source does not fetch it again
+	_, err = clients.lookupCache.get("tenancy/"+*request.TenancyId, func() (interface{}, error) {
+		return clients.identityClient.GetTenancy(ctx, request)
+	})
+	if serviceError, ok := oci_common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == http.StatusUnauthorized {
+		return fmt.Errorf("the credentials were rejected by the service: %s\n"+
+			"Check that the tenancy_ocid, user_ocid, fingerprint and private key match an API key of the user, and that the system clock is accurate. "+
+			"Set skip_credentials_validation to skip this check", serviceError.GetMessage())
+	}
+	if err != nil {
+		log.Printf("[DEBUG] unable to validate the credentials: %v", err)
+	}
+
+	return nil
+}
+
+// setServiceEndpoints overrides the host of every client of a service that has an endpoint configured
+func setServiceEndpoints(clients *OracleClients, endpoints map[string]interface{}) {
+	serviceClients := map[string][]*oci_common.BaseClient{
+		"core": {
+			&clients.blockstorageClient.BaseClient,
+			&clients.computeClient.BaseClient,
+			&clients.computeManagementClient.BaseClient,
+			&clients.virtualNetworkClient.BaseClient,
+		},
+		"database":       {&clients.databaseClient.BaseClient},
+		"identity":       {&clients.identityClient.BaseClient},
+		"load_balancer":  {&clients.loadBalancerClient.BaseClient},
+		"object_storage": {&clients.objectStorageClient.BaseClient},
+	}
+
+	for service, baseClients := range serviceClients {
+		endpoint, ok := endpoints[service].(string)
+		if !ok || endpoint == "" {
+			continue
+		}
+		for _, baseClient := range baseClients {
+			baseClient.Host = endpoint
+		}
+	}
+}
+
 type OracleClients struct {
 	auditClient             *oci_audit.AuditClient
 	blockstorageClient      *oci_core.BlockstorageClient
@@ -280,6 +348,7 @@ type OracleClients struct {
 	objectStorageClient     *oci_object_storage.ObjectStorageClient
 	virtualNetworkClient    *oci_core.VirtualNetworkClient
 	configuration           map[string]string
+	lookupCache             *lookupCache
 }
 
 func (m *OracleClients) KmsCryptoClient(endpoint string) (*oci_kms.KmsCryptoClient, error) {
@@ -0,0 +1,50 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"net/http"
+	"sync"
+)
+
+// concurrencyLimitingTransport caps the number of requests in flight to each service endpoint, so that applies
+// with a high parallelism do not exceed the request rate limits of the tenancy.
+type concurrencyLimitingTransport struct {
+	transport  http.RoundTripper
+	limit      int
+	mutex      sync.Mutex
+	semaphores map[string]chan struct{}
+}
+
+func newConcurrencyLimitingTransport(transport http.RoundTripper, limit int) *concurrencyLimitingTransport {
+	return &concurrencyLimitingTransport{
+		transport:  transport,
+		limit:      limit,
+		semaphores: map[string]chan struct{}{},
+	}
+}
+
+func (t *concurrencyLimitingTransport) getSemaphore(host string) chan struct{} {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	semaphore, ok := t.semaphores[host]
+	if !ok {
+		semaphore = make(chan struct{}, t.limit)
+		t.semaphores[host] = semaphore
+	}
+	return semaphore
+}
+
+func (t *concurrencyLimitingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	semaphore := t.getSemaphore(request.URL.Host)
+
+	select {
+	case semaphore <- struct{}{}:
+	case <-request.Context().Done():
+		return nil, request.Context().Err()
+	}
+	defer func() { <-semaphore }()
+
+	return t.transport.RoundTrip(request)
+}
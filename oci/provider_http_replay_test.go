@@ -0,0 +1,311 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+// HTTP record/replay lets the acceptance tests run without a tenancy. The tests are run once against a real tenancy
+// with http_replay_mode set to "record", which saves every response to the http_replay_file. Running them again with
+// "replay" serves the saved responses instead of calling the services, and requests are not signed so that no
+// credentials are needed. It is only compiled into the test binary, so the provider never sends unsigned requests or
+// writes responses to disk.
+//
+// The values of the fields marked Sensitive in the resource schemas, such as passwords and tokens, are redacted from
+// the request and response bodies before they are written, and so are the opc-* response headers that are not needed
+// to replay a request. Recordings still contain the OCIDs and names of the tenancy and must not be committed.
+const (
+	httpReplayModeEnv     = "http_replay_mode"
+	httpReplayFileEnv     = "http_replay_file"
+	httpReplayModeRecord  = "record"
+	httpReplayModeReplay  = "replay"
+	httpReplayFileDefault = "http_replay.json"
+	httpReplayRedacted    = "REDACTED"
+)
+
+// httpReplayKeptHeaders are the opc-* response headers that the SDK needs to replay a request, e.g. to page through a
+// list or to follow a work request
+var httpReplayKeptHeaders = map[string]bool{
+	"Opc-Next-Page":       true,
+	"Opc-Prev-Page":       true,
+	"Opc-Total-Items":     true,
+	"Opc-Work-Request-Id": true,
+}
+
+var (
+	httpReplaySensitiveKeys     map[string]bool
+	httpReplaySensitiveKeysOnce sync.Once
+)
+
+// getHttpReplaySensitiveKeys returns the names of the Sensitive fields of the resources, lower case and without
+// underscores, so that admin_password matches the adminPassword key of a request body
+func getHttpReplaySensitiveKeys() map[string]bool {
+	httpReplaySensitiveKeysOnce.Do(func() {
+		httpReplaySensitiveKeys = map[string]bool{}
+		var addSensitiveKeys func(map[string]*schema.Schema)
+		addSensitiveKeys = func(fields map[string]*schema.Schema) {
+			for name, field := range fields {
+				if field.Sensitive {
+					httpReplaySensitiveKeys[normalizeHttpReplayKey(name)] = true
+				}
+				if elem, ok := field.Elem.(*schema.Resource); ok {
+					addSensitiveKeys(elem.Schema)
+				}
+			}
+		}
+		for _, resource := range resourcesMap() {
+			addSensitiveKeys(resource.Schema)
+		}
+	})
+	return httpReplaySensitiveKeys
+}
+
+func normalizeHttpReplayKey(key string) string {
+	return strings.ToLower(strings.Replace(key, "_", "", -1))
+}
+
+// redactHttpReplayBody replaces the values of the sensitive fields of a JSON body. Other bodies are kept as they are.
+func redactHttpReplayBody(body string) string {
+	var content interface{}
+	if err := json.Unmarshal([]byte(body), &content); err != nil {
+		return body
+	}
+
+	redacted := false
+	var redact func(interface{})
+	redact = func(value interface{}) {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			for key, field := range value {
+				if _, ok := field.(string); ok && getHttpReplaySensitiveKeys()[normalizeHttpReplayKey(key)] {
+					value[key] = httpReplayRedacted
+					redacted = true
+					continue
+				}
+				redact(field)
+			}
+		case []interface{}:
+			for _, item := range value {
+				redact(item)
+			}
+		}
+	}
+	redact(content)
+
+	if !redacted {
+		return body
+	}
+	redactedBody, err := json.Marshal(content)
+	if err != nil {
+		return body
+	}
+	return string(redactedBody)
+}
+
+// redactHttpReplayHeader returns a copy of the response header without the credentials and opc-* headers
+func redactHttpReplayHeader(header http.Header) http.Header {
+	redactedHeader := http.Header{}
+	for key, values := range header {
+		key = http.CanonicalHeaderKey(key)
+		if key == "Authorization" {
+			continue
+		}
+		if strings.HasPrefix(key, "Opc-") && !httpReplayKeptHeaders[key] {
+			values = []string{httpReplayRedacted}
+		}
+		redactedHeader[key] = values
+	}
+	return redactedHeader
+}
+
+func init() {
+	wrapTestTransport = func(transport http.RoundTripper) (http.RoundTripper, error) {
+		if httpReplayMode := getEnvSettingWithBlankDefault(httpReplayModeEnv); httpReplayMode != "" {
+			return newHttpReplayTransport(transport, httpReplayMode, getEnvSettingWithDefault(httpReplayFileEnv, httpReplayFileDefault))
+		}
+		return transport, nil
+	}
+	testRequestSigner = func() oci_common.HTTPRequestSigner {
+		if getEnvSettingWithBlankDefault(httpReplayModeEnv) == httpReplayModeReplay {
+			return httpReplayRequestSigner{}
+		}
+		return nil
+	}
+}
+
+type httpInteraction struct {
+	Method       string      `json:"method"`
+	Url          string      `json:"url"`
+	RequestBody  string      `json:"request_body,omitempty"`
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header,omitempty"`
+	ResponseBody string      `json:"response_body,omitempty"`
+}
+
+func (i httpInteraction) key() string {
+	return i.Method + " " + i.Url + " " + i.RequestBody
+}
+
+// httpReplayCassette holds the interactions of a test run. It is shared by all the providers configured in the
+// process, since each acceptance test configures its own provider.
+type httpReplayCassette struct {
+	mutex        sync.Mutex
+	file         string
+	interactions []httpInteraction
+	// index of the next interaction to replay for each request
+	replayIndex map[string]int
+}
+
+var (
+	httpReplayCassettes      = map[string]*httpReplayCassette{}
+	httpReplayCassettesMutex sync.Mutex
+)
+
+func getHttpReplayCassette(mode string, file string) (*httpReplayCassette, error) {
+	httpReplayCassettesMutex.Lock()
+	defer httpReplayCassettesMutex.Unlock()
+
+	if cassette, ok := httpReplayCassettes[file]; ok {
+		return cassette, nil
+	}
+
+	cassette := &httpReplayCassette{file: file, replayIndex: map[string]int{}}
+	if mode == httpReplayModeReplay {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("can not read the HTTP replay file: %v", err)
+		}
+		if err := json.Unmarshal(content, &cassette.interactions); err != nil {
+			return nil, fmt.Errorf("can not parse the HTTP replay file %s: %v", file, err)
+		}
+	}
+
+	httpReplayCassettes[file] = cassette
+	return cassette, nil
+}
+
+func (c *httpReplayCassette) record(interaction httpInteraction) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.interactions = append(c.interactions, interaction)
+
+	// The whole file is written after each request so that nothing is lost when a test run is interrupted
+	content, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.file, content, 0600)
+}
+
+// replay returns the recorded interactions for the same request in the order they were recorded. Once they are used
+// up, the last one is returned again, since the number of polling requests while waiting for a state varies.
+func (c *httpReplayCassette) replay(request httpInteraction) (httpInteraction, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := request.key()
+	var matches []httpInteraction
+	for _, interaction := range c.interactions {
+		if interaction.key() == key {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return httpInteraction{}, false
+	}
+
+	index := c.replayIndex[key]
+	if index >= len(matches) {
+		return matches[len(matches)-1], true
+	}
+	c.replayIndex[key] = index + 1
+	return matches[index], true
+}
+
+type httpReplayTransport struct {
+	transport http.RoundTripper
+	mode      string
+	cassette  *httpReplayCassette
+}
+
+func newHttpReplayTransport(transport http.RoundTripper, mode string, file string) (*httpReplayTransport, error) {
+	if mode != httpReplayModeRecord && mode != httpReplayModeReplay {
+		return nil, fmt.Errorf("%s must be '%s' or '%s', got '%s'", httpReplayModeEnv, httpReplayModeRecord, httpReplayModeReplay, mode)
+	}
+
+	cassette, err := getHttpReplayCassette(mode, file)
+	if err != nil {
+		return nil, err
+	}
+	return &httpReplayTransport{transport: transport, mode: mode, cassette: cassette}, nil
+}
+
+func (t *httpReplayTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	interaction := httpInteraction{Method: request.Method, Url: request.URL.String()}
+	if request.Body != nil {
+		body, err := ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		// The recorded body is redacted in both modes, so that the redacted recording matches the request
+		interaction.RequestBody = redactHttpReplayBody(string(body))
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	if t.mode == httpReplayModeReplay {
+		recorded, ok := t.cassette.replay(interaction)
+		if !ok {
+			return nil, fmt.Errorf("no recorded response for %s %s in %s", request.Method, request.URL, t.cassette.file)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Header,
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(recorded.ResponseBody))),
+			ContentLength: int64(len(recorded.ResponseBody)),
+			Request:       request,
+		}, nil
+	}
+
+	response, err := t.transport.RoundTrip(request)
+	if err != nil {
+		return response, err
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	interaction.StatusCode = response.StatusCode
+	interaction.Header = redactHttpReplayHeader(response.Header)
+	interaction.ResponseBody = redactHttpReplayBody(string(body))
+	if err := t.cassette.record(interaction); err != nil {
+		return nil, fmt.Errorf("can not write the HTTP replay file %s: %v", t.cassette.file, err)
+	}
+	return response, nil
+}
+
+// httpReplayRequestSigner leaves requests unsigned, replayed requests never reach the services
+type httpReplayRequestSigner struct{}
+
+func (s httpReplayRequestSigner) Sign(r *http.Request) error {
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// loggingTransport logs a summary of each API call so that failed requests can be correlated with the
+// service using their opc-request-id. Headers and bodies are not logged since they may contain credentials.
+// Terraform only shows these messages when TF_LOG is DEBUG or more verbose.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+func (t loggingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	startTime := time.Now()
+	response, err := t.transport.RoundTrip(request)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[DEBUG] %s %s failed after %v: %v", request.Method, request.URL.Path, duration, err)
+		return response, err
+	}
+
+	log.Printf("[DEBUG] %s %s returned %d in %v (%s: %s)", request.Method, request.URL.Path, response.StatusCode, duration,
+		requestHeaderOpcRequestId, response.Header.Get(requestHeaderOpcRequestId))
+	return response, err
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"sync"
+)
+
+// lookupCache keeps the results of lookups that do not change while Terraform runs, like the availability domains,
+// the object storage namespace and the tenancy, so that refreshing a large state fetches them once per provider
+// instead of once per data source. Failed lookups are not cached.
+type lookupCache struct {
+	mutex   sync.Mutex
+	entries map[string]*lookupCacheEntry
+}
+
+type lookupCacheEntry struct {
+	ready chan struct{}
+	value interface{}
+	err   error
+}
+
+func newLookupCache() *lookupCache {
+	return &lookupCache{entries: map[string]*lookupCacheEntry{}}
+}
+
+// get returns the cached value for the key, or calls load to get it. Concurrent calls for the same key wait for the
+// first one to finish instead of making the same request.
+func (c *lookupCache) get(key string, load func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return load()
+	}
+
+	c.mutex.Lock()
+	if entry, ok := c.entries[key]; ok {
+		c.mutex.Unlock()
+		<-entry.ready
+		return entry.value, entry.err
+	}
+	entry := &lookupCacheEntry{ready: make(chan struct{})}
+	c.entries[key] = entry
+	c.mutex.Unlock()
+
+	entry.value, entry.err = load()
+	if entry.err != nil {
+		c.mutex.Lock()
+		delete(c.entries, key)
+		c.mutex.Unlock()
+	}
+	close(entry.ready)
+
+	return entry.value, entry.err
+}
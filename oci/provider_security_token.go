@@ -0,0 +1,93 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const securityTokenFileConfigKey = "security_token_file"
+
+// securityTokenConfigProvider signs requests with a session token created by `oci session authenticate`. The token
+// is read again for every request, so that sessions refreshed with `oci session refresh` are picked up.
+type securityTokenConfigProvider struct {
+	oci_common.ConfigurationProvider
+	securityTokenFile string
+}
+
+func (p securityTokenConfigProvider) KeyID() (string, error) {
+	token, err := ioutil.ReadFile(p.securityTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("can not read the security token from %s: %v", p.securityTokenFile, err)
+	}
+	return "ST$" + strings.TrimSpace(string(token)), nil
+}
+
+// The region of the session is not used, it is always taken from the provider configuration
+func (p securityTokenConfigProvider) Region() (string, error) {
+	return "", fmt.Errorf("can not get %s from the security token configuration", regionAttrName)
+}
+
+// getSecurityTokenConfigProvider returns a provider for the first SDK or CLI config file that has a security
+// token file for the given profile
+func getSecurityTokenConfigProvider(profile string, privateKeyPassword string) (oci_common.ConfigurationProvider, error) {
+	homeFolder := getHomeFolder()
+	for _, configFile := range []string{filepath.Join(homeFolder, ".oci", "config"), filepath.Join(homeFolder, ".oraclebmc", "config")} {
+		securityTokenFile, err := readConfigFileValue(configFile, profile, securityTokenFileConfigKey)
+		if err != nil || securityTokenFile == "" {
+			continue
+		}
+
+		cfg, err := oci_common.ConfigurationProviderFromFileWithProfile(configFile, profile, privateKeyPassword)
+		if err != nil {
+			return nil, err
+		}
+		return securityTokenConfigProvider{cfg, expandHomeFolder(securityTokenFile, homeFolder)}, nil
+	}
+
+	return nil, fmt.Errorf("when auth is set to '%s', the '%s' profile must have a %s in ~/.oci/config or ~/.oraclebmc/config",
+		authSecurityTokenSetting, profile, securityTokenFileConfigKey)
+}
+
+// readConfigFileValue returns the value of a key in a profile of a config file, or an empty string if it is not set
+func readConfigFileValue(configFile string, profile string, key string) (string, error) {
+	file, err := os.Open(configFile)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	inProfile := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inProfile = strings.TrimSpace(line[1:len(line)-1]) == profile
+			continue
+		}
+		if !inProfile {
+			continue
+		}
+		if parts := strings.SplitN(line, "=", 2); len(parts) == 2 && strings.TrimSpace(parts[0]) == key {
+			return strings.TrimSpace(parts[1]), nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+func expandHomeFolder(path string, homeFolder string) string {
+	if strings.HasPrefix(path, "~/") {
+		return filepath.Join(homeFolder, path[2:])
+	}
+	return path
+}
@@ -3,12 +3,20 @@
 package provider
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"log"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"runtime"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -340,7 +348,29 @@ var testKeyFingerPrint = "b4:8a:7d:54:e6:81:04:b2:fa:ce:ba:55:34:dd:00:00"
 var testTenancyOCID = "ocid1.tenancy.oc1..faketenancy"
 var testUserOCID = "ocid1.user.oc1..fakeuser"
 
+// useEmptyDefaultConfigProvider makes the provider read an empty config file instead of the config files of the machine
+// running the test, and returns a function that restores the default
+func useEmptyDefaultConfigProvider(t *testing.T) func() {
+	configFile, err := ioutil.TempFile("", "config")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configFile.Close()
+
+	defaultProvider := defaultConfigProvider
+	defaultConfigProvider = func() oci_common.ConfigurationProvider {
+		configProvider, _ := oci_common.ConfigurationProviderFromFile(configFile.Name(), "")
+		return configProvider
+	}
+	return func() {
+		defaultConfigProvider = defaultProvider
+		os.Remove(configFile.Name())
+	}
+}
+
 func providerConfigTest(t *testing.T, disableRetries bool, skipRequiredField bool, auth string) {
+	defer useEmptyDefaultConfigProvider(t)()
+
 	r := &schema.Resource{
 		Schema: schemaMap(),
 	}
@@ -356,6 +386,7 @@ func providerConfigTest(t *testing.T, disableRetries bool, skipRequiredField boo
 	//d.Set("private_key_path", "")
 	d.Set("private_key_password", "password")
 	d.Set("region", "us-phoenix-1")
+	d.Set("skip_credentials_validation", true)
 
 	if disableRetries {
 		d.Set("disable_auto_retries", disableRetries)
@@ -410,6 +441,376 @@ func TestProviderConfig(t *testing.T) {
 	providerConfigTest(t, true, false, "invalid-auth-setting")        // Invalid auth + disable auto-retries
 }
 
+func TestProviderConfigWithConfigFileProfile(t *testing.T) {
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.SetId("tenancy_ocid")
+	d.Set("auth", authAPIKeySetting)
+	d.Set("region", "us-phoenix-1")
+	d.Set("skip_credentials_validation", true)
+	d.Set("config_file_profile", "DEFAULT")
+
+	// Credentials missing from the provider configuration are expected to come from the profile
+	_, err := ProviderConfig(d)
+	if err != nil {
+		assert.NotContains(t, err.Error(), "tenancy_ocid, user_ocid, and fingerprint are required")
+	}
+
+	configProviders, err := getConfigProvidersForProfile("DEFAULT", "")
+	assert.Nil(t, err)
+	assert.Len(t, configProviders, 2)
+}
+
+func TestProviderConfigWithDefaultTimeouts(t *testing.T) {
+	configured := Provider(func(d *schema.ResourceData) (interface{}, error) { return nil, nil }).(*schema.Provider)
+	aliased := Provider(func(d *schema.ResourceData) (interface{}, error) { return nil, nil }).(*schema.Provider)
+
+	d := (&schema.Resource{Schema: configured.Schema}).Data(nil)
+	d.Set("default_timeout_minutes", []interface{}{map[string]interface{}{"create": 60, "delete": 90}})
+
+	_, err := configured.ConfigureFunc(d)
+	assert.NoError(t, err)
+
+	vcn := configured.ResourcesMap["oci_core_vcn"].Timeouts
+	assert.Equal(t, 60*time.Minute, *vcn.Create)
+	assert.Equal(t, FifteenMinutes, *vcn.Update)
+	assert.Equal(t, 90*time.Minute, *vcn.Delete)
+
+	instance := configured.ResourcesMap["oci_core_instance"].Timeouts
+	assert.Equal(t, 60*time.Minute, *instance.Create, "Resources with their own timeouts are expected to use the configured ones")
+	assert.Equal(t, 90*time.Minute, *instance.Delete)
+	assert.Equal(t, 60*time.Minute, *configured.ResourcesMap["oci_database_db_system"].Timeouts.Create)
+
+	assert.Equal(t, FifteenMinutes, *DefaultTimeout.Create, "DefaultTimeout is not expected to change")
+	assert.Equal(t, FifteenMinutes, *aliased.ResourcesMap["oci_core_vcn"].Timeouts.Create, "Other providers are expected to keep their timeouts")
+	assert.Equal(t, TwoHours, *aliased.ResourcesMap["oci_core_instance"].Timeouts.Create)
+}
+
+func TestProviderConfigWithSecurityToken(t *testing.T) {
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.SetId("tenancy_ocid")
+	d.Set("auth", authSecurityTokenSetting)
+	d.Set("region", "us-phoenix-1")
+	d.Set("skip_credentials_validation", true)
+
+	_, err := ProviderConfig(d)
+	assert.Error(t, err, "Expected an error when the config_file_profile is not set")
+
+	tempDir, err := ioutil.TempDir(os.TempDir(), "security_token")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tempDir)
+
+	configFile := tempDir + "/config"
+	tokenFile := tempDir + "/token"
+	config := fmt.Sprintf("[DEFAULT]\nregion=us-ashburn-1\n\n[my-session]\nfingerprint=%s\ntenancy=%s\n%s = %s\n", testKeyFingerPrint, testTenancyOCID, securityTokenFileConfigKey, tokenFile)
+	assert.Nil(t, ioutil.WriteFile(configFile, []byte(config), 0600))
+	assert.Nil(t, ioutil.WriteFile(tokenFile, []byte("first-token\n"), 0600))
+
+	value, err := readConfigFileValue(configFile, "my-session", securityTokenFileConfigKey)
+	assert.Nil(t, err)
+	assert.Equal(t, tokenFile, value)
+
+	value, err = readConfigFileValue(configFile, "DEFAULT", securityTokenFileConfigKey)
+	assert.Nil(t, err)
+	assert.Empty(t, value)
+
+	cfg := securityTokenConfigProvider{oci_common.NewRawConfigurationProvider(testTenancyOCID, "", "us-ashburn-1", testKeyFingerPrint, testPrivateKey, nil), tokenFile}
+	keyId, err := cfg.KeyID()
+	assert.Nil(t, err)
+	assert.Equal(t, "ST$first-token", keyId)
+
+	// A refreshed session is picked up without creating a new provider
+	assert.Nil(t, ioutil.WriteFile(tokenFile, []byte("refreshed-token"), 0600))
+	keyId, err = cfg.KeyID()
+	assert.Nil(t, err)
+	assert.Equal(t, "ST$refreshed-token", keyId)
+
+	_, err = cfg.Region()
+	assert.Error(t, err, "Expected the region to come from the provider configuration")
+}
+
+func TestValidateConfigForAPIKeyAuthDiscoversTenancy(t *testing.T) {
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.Set("user_ocid", testUserOCID)
+	d.Set("fingerprint", testKeyFingerPrint)
+
+	// The SDK's default configuration reads the TF_VAR_ variables after the config files
+	if tenancyOcid, ok := os.LookupEnv("TF_VAR_tenancy_ocid"); ok {
+		defer os.Setenv("TF_VAR_tenancy_ocid", tenancyOcid)
+	} else {
+		defer os.Unsetenv("TF_VAR_tenancy_ocid")
+	}
+	os.Setenv("TF_VAR_tenancy_ocid", testTenancyOCID)
+	assert.Nil(t, validateConfigForAPIKeyAuth(d))
+
+	d = r.Data(nil)
+	d.Set("tenancy_ocid", testTenancyOCID)
+	d.Set("user_ocid", testUserOCID)
+	assert.Error(t, validateConfigForAPIKeyAuth(d), "Expected the fingerprint to be required")
+}
+
+func TestProviderConfigWithEndpointsAndUserAgentSuffix(t *testing.T) {
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.SetId("tenancy_ocid")
+	d.Set("auth", authAPIKeySetting)
+	d.Set("tenancy_ocid", testTenancyOCID)
+	d.Set("user_ocid", testUserOCID)
+	d.Set("fingerprint", testKeyFingerPrint)
+	d.Set("private_key", testPrivateKey)
+	d.Set("private_key_password", "password")
+	d.Set("region", "us-phoenix-1")
+	d.Set("skip_credentials_validation", true)
+	d.Set("endpoints", []interface{}{map[string]interface{}{
+		"core":     "http://localhost:8080",
+		"identity": "identity.example.com",
+	}})
+	d.Set("user_agent_suffix", "my-pipeline/1.0")
+
+	client, err := ProviderConfig(d)
+	assert.Nil(t, err)
+
+	oracleClient := client.(*OracleClients)
+	assert.Equal(t, "http://localhost:8080", oracleClient.computeClient.Host)
+	assert.Equal(t, "http://localhost:8080", oracleClient.virtualNetworkClient.Host)
+	assert.Equal(t, "identity.example.com", oracleClient.identityClient.Host)
+	assert.Equal(t, "database.us-phoenix-1.oraclecloud.com", oracleClient.databaseClient.Host)
+	assert.True(t, strings.HasSuffix(oracleClient.computeClient.UserAgent, " my-pipeline/1.0"))
+}
+
+func TestValidateRegion(t *testing.T) {
+	for _, region := range []string{"us-phoenix-1", "us-ashburn-1", "eu-frankfurt-1", "uk-london-1", "ca-toronto-1", "us-langley-1", "phx", "IAD"} {
+		_, errs := validateRegion(region, "region")
+		assert.Empty(t, errs, "expected %s to be a valid region", region)
+	}
+
+	for _, region := range []string{"", "us-phoenix", "us phoenix 1", "phoenix", "https://iaas.us-phoenix-1.oraclecloud.com"} {
+		_, errs := validateRegion(region, "region")
+		assert.NotEmpty(t, errs, "expected %s to be an invalid region", region)
+	}
+}
+
+func TestConcurrencyLimitingTransport(t *testing.T) {
+	var inFlight, maxInFlight int32
+	var mutex sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mutex.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mutex.Lock()
+		inFlight--
+		mutex.Unlock()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newConcurrencyLimitingTransport(http.DefaultTransport, 2)}
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			response, err := client.Get(server.URL)
+			if assert.Nil(t, err) {
+				response.Body.Close()
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	assert.True(t, maxInFlight <= 2, "expected at most 2 requests in flight, got %d", maxInFlight)
+}
+
+func TestHttpReplayTransport(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Etag", fmt.Sprintf("request-%d", requestCount))
+		w.Header().Set(requestHeaderOpcRequestId, "secret-request-id")
+		w.Header().Set("Opc-Next-Page", "page-2")
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Header.Get("Content-Type") == "application/json" {
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(fmt.Sprintf("%s %s", r.Method, body)))
+	}))
+
+	tempDir, err := ioutil.TempDir(os.TempDir(), "http_replay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tempDir)
+	file := tempDir + "/http_replay.json"
+
+	readResponse := func(response *http.Response, err error) string {
+		if !assert.Nil(t, err) {
+			return ""
+		}
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+		return response.Header.Get("Etag") + " " + string(body)
+	}
+
+	recordTransport, err := newHttpReplayTransport(http.DefaultTransport, httpReplayModeRecord, file)
+	assert.Nil(t, err)
+	client := &http.Client{Transport: recordTransport}
+	assert.Equal(t, "request-1 GET ", readResponse(client.Get(server.URL)))
+	assert.Equal(t, "request-2 GET ", readResponse(client.Get(server.URL)))
+	assert.Equal(t, "request-3 POST body", readResponse(client.Post(server.URL, "text/plain", strings.NewReader("body"))))
+	assert.Equal(t, `request-4 {"adminPassword": "secret", "displayName": "db"}`,
+		readResponse(client.Post(server.URL, "application/json", strings.NewReader(`{"adminPassword": "secret", "displayName": "db"}`))))
+	server.Close()
+
+	recording, err := ioutil.ReadFile(file)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(recording), "secret", "Expected the sensitive fields and opc headers to be redacted")
+
+	// The recording is read from the file when the replay starts
+	delete(httpReplayCassettes, file)
+	replayTransport, err := newHttpReplayTransport(http.DefaultTransport, httpReplayModeReplay, file)
+	assert.Nil(t, err)
+	client = &http.Client{Transport: replayTransport}
+	assert.Equal(t, "request-3 POST body", readResponse(client.Post(server.URL, "text/plain", strings.NewReader("body"))))
+	assert.Equal(t, "request-1 GET ", readResponse(client.Get(server.URL)))
+	assert.Equal(t, "request-2 GET ", readResponse(client.Get(server.URL)))
+	assert.Equal(t, "request-2 GET ", readResponse(client.Get(server.URL)), "Expected the last response to be replayed again")
+
+	response, err := client.Post(server.URL, "application/json", strings.NewReader(`{"adminPassword": "other secret", "displayName": "db"}`))
+	if assert.Nil(t, err, "Expected a request to match the recording regardless of its sensitive fields") {
+		assert.Equal(t, httpReplayRedacted, response.Header.Get(requestHeaderOpcRequestId))
+		assert.Equal(t, "page-2", response.Header.Get("Opc-Next-Page"))
+		assert.Equal(t, `request-4 {"adminPassword":"REDACTED","displayName":"db"}`, readResponse(response, err))
+	}
+
+	_, err = client.Post(server.URL, "text/plain", strings.NewReader("other body"))
+	assert.Error(t, err, "Expected an error for a request that was not recorded")
+
+	_, err = newHttpReplayTransport(http.DefaultTransport, "playback", file)
+	assert.Error(t, err)
+}
+
+func TestLookupCache(t *testing.T) {
+	cache := newLookupCache()
+	var loadCount int32
+	load := func() (interface{}, error) {
+		atomic.AddInt32(&loadCount, 1)
+		time.Sleep(20 * time.Millisecond)
+		return "namespace", nil
+	}
+
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			value, err := cache.get("namespace", load)
+			assert.Nil(t, err)
+			assert.Equal(t, "namespace", value)
+		}()
+	}
+	waitGroup.Wait()
+	assert.Equal(t, int32(1), loadCount, "Expected concurrent lookups to be loaded once")
+
+	_, err := cache.get("tenancy/ocid1.tenancy.oc1..aaaa", func() (interface{}, error) {
+		return nil, fmt.Errorf("transient error")
+	})
+	assert.Error(t, err)
+	value, err := cache.get("tenancy/ocid1.tenancy.oc1..aaaa", func() (interface{}, error) {
+		return "tenancy", nil
+	})
+	assert.Nil(t, err, "Expected failed lookups not to be cached")
+	assert.Equal(t, "tenancy", value)
+
+	// Clients created without a cache always load
+	var noCache *lookupCache
+	value, err = noCache.get("namespace", func() (interface{}, error) { return "uncached", nil })
+	assert.Nil(t, err)
+	assert.Equal(t, "uncached", value)
+}
+
+func TestValidateCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code": "NotAuthenticated", "message": "The required information to complete authentication was not provided."}`))
+	}))
+	defer server.Close()
+
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.SetId("tenancy_ocid")
+	d.Set("auth", authAPIKeySetting)
+	d.Set("tenancy_ocid", testTenancyOCID)
+	d.Set("user_ocid", testUserOCID)
+	d.Set("fingerprint", testKeyFingerPrint)
+	d.Set("private_key", testPrivateKey)
+	d.Set("private_key_password", "password")
+	d.Set("region", "us-phoenix-1")
+	d.Set("endpoints", []interface{}{map[string]interface{}{"identity": server.URL}})
+
+	_, err := ProviderConfig(d)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "the credentials were rejected by the service")
+	}
+
+	d.Set("skip_credentials_validation", true)
+	_, err = ProviderConfig(d)
+	assert.Nil(t, err)
+}
+
+func TestSkipCredentialsValidationFromEnvironment(t *testing.T) {
+	attribute := schemaMap()[skipCredentialsValidationAttrName]
+
+	value, err := attribute.DefaultValue()
+	assert.Nil(t, err)
+	assert.Equal(t, false, value)
+
+	os.Setenv(ociVarName(skipCredentialsValidationAttrName), "true")
+	defer os.Unsetenv(ociVarName(skipCredentialsValidationAttrName))
+	value, err = attribute.DefaultValue()
+	assert.Nil(t, err)
+	assert.Equal(t, "true", value)
+}
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestHeaderOpcRequestId, "fake-request-id")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: loggingTransport{http.DefaultTransport}}
+	request, _ := http.NewRequest(http.MethodGet, server.URL+"/20160918/instances", nil)
+	request.Header.Set("Authorization", "secret-signature")
+	response, err := client.Do(request)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	assert.Contains(t, buf.String(), "[DEBUG] GET /20160918/instances returned 404")
+	assert.Contains(t, buf.String(), "opc-request-id: fake-request-id")
+	assert.NotContains(t, buf.String(), "secret-signature")
+}
+
 func TestVerifyConfigForAPIKeyAuthIsNotSet_basic(t *testing.T) {
 	r := &schema.Resource{
 		Schema: schemaMap(),
@@ -18,12 +18,22 @@ const (
 var shortRetryTime = 2 * time.Minute
 var longRetryTime = 10 * time.Minute
 var configuredRetryDuration *time.Duration
+var configuredMaxRetries *uint
+
+// createdNotFoundGracePeriod is how long reading a resource returns not found after it was created before the
+// resource is treated as missing
+var createdNotFoundGracePeriod = time.Minute
+var createdNotFoundRetryInterval = 5 * time.Second
+
+// configuredCreateFailureRetries is how many times the creation of a resource that failed for a transient reason is
+// requested again
+var configuredCreateFailureRetries uint
 
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
 
-func getRetryBackoffDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time) time.Duration {
+func getRetryBackoffDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, operationDeadline time.Time) time.Duration {
 	// Avoid having a very large retry backoff
 	attempt := response.AttemptNumber
 	if attempt > quadraticBackoffCap {
@@ -36,7 +46,7 @@ func getRetryBackoffDuration(response oci_common.OCIOperationResponse, disableNo
 
 	// If we are about to exceed the retry duration; then reduce the backoff so that next attempt happens roughly when
 	// the entire retry duration is supposed to expire. Jitter is necessary again to avoid clustering.
-	expectedRetryDuration := getExpectedRetryDuration(response, disableNotFoundRetries, service)
+	expectedRetryDuration := getExpectedRetryDuration(response, disableNotFoundRetries, service, startTime, operationDeadline)
 	timeWaited := getElapsedRetryDuration(startTime)
 	if timeWaited < expectedRetryDuration && timeWaited+backoffDuration > expectedRetryDuration {
 		extraJitterRange := int64(float64(expectedRetryDuration) * 0.05)
@@ -52,7 +62,9 @@ func getElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
 	return time.Now().Sub(firstAttemptTime)
 }
 
-func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string) time.Duration {
+// getExpectedRetryDuration returns how long after startTime a failed request is retried. A zero operationDeadline means
+// the request is not made by a Create, Update or Delete.
+func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, operationDeadline time.Time) time.Duration {
 	if response.Response == nil || response.Response.HTTPResponse() == nil {
 		return 0
 	}
@@ -89,6 +101,12 @@ func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableN
 		if e != nil && strings.Contains(e.Error(), "NotAuthorizedOrResourceAlreadyExists") && (service == identityService || service == objectstorageService) {
 			return longRetryTime
 		}
+		// A resource that is transitioning, e.g. while a load balancer work request is in progress or a DB system is
+		// being patched, rejects other operations for longer than the default retry time, so a Create, Update or
+		// Delete keeps retrying until its timeout expires
+		if isConflictError(e) && !operationDeadline.IsZero() {
+			return operationDeadline.Sub(startTime)
+		}
 	case 412:
 		return 0
 	case 429:
@@ -107,23 +125,51 @@ func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableN
 	return shortRetryTime
 }
 
-func shouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time) bool {
-	return getElapsedRetryDuration(startTime) < getExpectedRetryDuration(response, disableNotFoundRetries, service)
+func shouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, operationDeadline time.Time) bool {
+	// The first attempt is not a retry
+	if configuredMaxRetries != nil && response.AttemptNumber > *configuredMaxRetries {
+		return false
+	}
+	return getElapsedRetryDuration(startTime) < getExpectedRetryDuration(response, disableNotFoundRetries, service, startTime, operationDeadline)
 }
 
 // Because this function notes the start time for making should retry decisions, it's advised
 // for this function call to be made immediately before the client API call.
 func getRetryPolicy(disableNotFoundRetries bool, service string) *oci_common.RetryPolicy {
+	return getOperationRetryPolicy(disableNotFoundRetries, service, time.Time{})
+}
+
+// getOperationRetryPolicy returns the retry policy of a request made by a Create, Update or Delete whose timeout
+// expires at operationDeadline
+func getOperationRetryPolicy(disableNotFoundRetries bool, service string, operationDeadline time.Time) *oci_common.RetryPolicy {
 	startTime := time.Now()
 	retryPolicy := &oci_common.RetryPolicy{
 		MaximumNumberAttempts: 0,
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
-			return shouldRetry(response, disableNotFoundRetries, service, startTime)
+			return shouldRetry(response, disableNotFoundRetries, service, startTime, operationDeadline)
 		},
 		NextDuration: func(response oci_common.OCIOperationResponse) time.Duration {
-			return getRetryBackoffDuration(response, disableNotFoundRetries, service, startTime)
+			return getRetryBackoffDuration(response, disableNotFoundRetries, service, startTime, operationDeadline)
 		},
 	}
 
 	return retryPolicy
 }
+
+// isConflictError returns true for the errors returned when a resource, or a resource it depends on, is in a state that
+// does not allow the operation yet, e.g. while a load balancer work request is in progress or an instance is starting.
+// The request is retried on its own, since the operation it belongs to may have made other requests that succeeded.
+func isConflictError(err error) bool {
+	serviceError, ok := oci_common.IsServiceError(err)
+	if !ok || serviceError.GetHTTPStatusCode() != 409 {
+		return false
+	}
+	return serviceError.GetCode() == "IncorrectState" || serviceError.GetCode() == "Conflict"
+}
+
+// isTransientFailureMessage returns true for the messages of work requests and resources that failed because of an
+// internal error or a temporary lack of capacity, which may not happen again when the operation is retried
+func isTransientFailureMessage(message string) bool {
+	message = strings.ToLower(message)
+	return strings.Contains(message, "internal error") || strings.Contains(message, "capacity")
+}
@@ -1,12 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/oracle/oci-go-sdk/common"
 )
 
@@ -34,7 +37,7 @@ func retryLoop(t *testing.T, r *retryTestInput) {
 		operationResponse := common.NewOCIOperationResponse(TestOCIResponse{statusCode: r.httpResponseStatusCode}, fmt.Errorf("Retriable error"), i)
 
 		expectedShouldRetry := getElapsedRetryDuration(startTime) < (time.Duration(r.expectedRetryTimeSeconds) * time.Second)
-		actualShouldRetry := shouldRetry(operationResponse, r.disableNotFoundRetries, r.serviceName, startTime)
+		actualShouldRetry := shouldRetry(operationResponse, r.disableNotFoundRetries, r.serviceName, startTime, time.Time{})
 		if actualShouldRetry != expectedShouldRetry {
 			t.Errorf("Expected shouldRetry to return %v for attempt %v", expectedShouldRetry, i)
 			return
@@ -45,7 +48,7 @@ func retryLoop(t *testing.T, r *retryTestInput) {
 			return
 		}
 
-		waitTime := getRetryBackoffDuration(operationResponse, r.disableNotFoundRetries, r.serviceName, startTime)
+		waitTime := getRetryBackoffDuration(operationResponse, r.disableNotFoundRetries, r.serviceName, startTime, time.Time{})
 		fmt.Printf("Attempt #%v: Will wait for %v ms\n", i, waitTime.Nanoseconds()/1000000)
 		expectedWaitTimeMax := time.Duration(2*i*i) * time.Second
 		if i > quadraticBackoffCap {
@@ -101,6 +104,25 @@ func TestRetryLoop_configuredRetryWith404(t *testing.T) {
 	retryLoop(t, &r)
 }
 
+// Retries should stop after the configured number of retries, even if the retry duration has not elapsed
+func TestRetryLoop_configuredMaxRetries(t *testing.T) {
+	shortRetryTime = 15 * time.Second
+	longRetryTime = 15 * time.Second
+	configuredRetryDuration = nil
+	maxRetries := uint(2)
+	configuredMaxRetries = &maxRetries
+	defer func() { configuredMaxRetries = nil }()
+
+	startTime := time.Now()
+	for i := uint(1); i <= 3; i++ {
+		operationResponse := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429}, fmt.Errorf("Retriable error"), i)
+		expectedShouldRetry := i <= maxRetries
+		if actualShouldRetry := shouldRetry(operationResponse, false, "core", startTime, time.Time{}); actualShouldRetry != expectedShouldRetry {
+			t.Errorf("Expected shouldRetry to return %v for attempt %v", expectedShouldRetry, i)
+		}
+	}
+}
+
 // Test concurrent retry loops
 func TestRetryLoop_concurrent(t *testing.T) {
 	shortRetryTime = 15 * time.Second
@@ -126,3 +148,54 @@ func TestRetryLoop_concurrent(t *testing.T) {
 
 	waitGroup.Wait()
 }
+
+func getTestServiceError(t *testing.T, statusCode int, code string) error {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		w.Write([]byte(fmt.Sprintf(`{"code": "%s", "message": "fake message"}`, code)))
+	}))
+	defer server.Close()
+
+	client := newTestBaseClient(server)
+	request, _ := http.NewRequest(http.MethodPut, "/loadBalancers", nil)
+	_, err := client.Call(context.Background(), request)
+	if _, ok := common.IsServiceError(err); !ok {
+		t.Fatalf("expected a service error, got %v", err)
+	}
+	return err
+}
+
+func TestRetryOnConflict(t *testing.T) {
+	incorrectStateError := getTestServiceError(t, 409, "IncorrectState")
+	alreadyExistsError := getTestServiceError(t, 409, "NotAuthorizedOrResourceAlreadyExists")
+
+	if !isConflictError(incorrectStateError) || !isConflictError(getTestServiceError(t, 409, "Conflict")) {
+		t.Errorf("expected IncorrectState and Conflict errors to be conflict errors")
+	}
+	if isConflictError(alreadyExistsError) || isConflictError(getTestServiceError(t, 400, "IncorrectState")) || isConflictError(fmt.Errorf("IncorrectState")) || isConflictError(nil) {
+		t.Errorf("expected only 409 IncorrectState and Conflict service errors to be conflict errors")
+	}
+
+	// During an operation, the request that was rejected is retried until the timeout of the operation expires
+	shortRetryTime = 15 * time.Second
+	startTime := time.Now()
+	operationDeadline := startTime.Add(30 * time.Second)
+	if duration := getExpectedRetryDuration(common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, incorrectStateError, 1), false, "core", startTime, operationDeadline); duration != 30*time.Second {
+		t.Errorf("expected conflict errors to be retried until the operation deadline, got %v", duration)
+	}
+	if duration := getExpectedRetryDuration(common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, incorrectStateError, 1), false, "core", startTime, time.Time{}); duration != shortRetryTime {
+		t.Errorf("expected conflict errors outside of an operation to be retried for %v, got %v", shortRetryTime, duration)
+	}
+	if duration := getExpectedRetryDuration(common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, alreadyExistsError, 1), false, "core", startTime, operationDeadline); duration != shortRetryTime {
+		t.Errorf("expected other 409 errors to be retried for %v, got %v", shortRetryTime, duration)
+	}
+
+	// The deadline is set from the timeout of the operation when it starts
+	d := VcnResource().TestResourceData()
+	crud := &VcnResourceCrud{BaseCrud: BaseCrud{D: d}}
+	setOperationTimeout(crud, d.Timeout(schema.TimeoutUpdate))
+	if remaining := crud.operationDeadline.Sub(time.Now()); remaining <= 0 || remaining > d.Timeout(schema.TimeoutUpdate) {
+		t.Errorf("expected the operation deadline to be within the update timeout, got %v", remaining)
+	}
+}
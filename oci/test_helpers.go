@@ -132,7 +132,7 @@ func conditionShouldRetry(timeout time.Duration, condition WaitConditionFunc, se
 		}
 
 		//Make sure we stop on default rules
-		if shouldRetry(response, disableNotFoundRetries, service, startTime) {
+		if shouldRetry(response, disableNotFoundRetries, service, startTime, time.Time{}) {
 			return true
 		}
 